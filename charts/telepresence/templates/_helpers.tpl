@@ -77,12 +77,15 @@ RBAC rules required to create an intercept in a namespace; excludes any rules th
   verbs: ["get"]
 - apiGroups: [""]
   resources: ["pods"]
-  verbs: ["list", "get"]
+  verbs: ["list", "get", "watch"]
 # Needed for the gather-traces command
 - apiGroups: [""]
   resources: ["pods/portforward"]
   verbs: ["create"]
 - apiGroups: ["apps"]
-  resources: ["deployments", "replicasets", "statefulsets"]
+  resources: ["deployments", "replicasets", "statefulsets", "daemonsets"]
   verbs: ["get", "watch", "list"]
+- apiGroups: ["argoproj.io"]
+  resources: ["rollouts"]
+  verbs: ["get", "list"]
 {{- end }}
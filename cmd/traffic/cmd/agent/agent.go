@@ -120,9 +120,10 @@ func sftpServer(ctx context.Context, sftpPortCh chan<- uint16) error {
 	}
 }
 
-func StartFileSharing(ctx context.Context, g *dgroup.Group, config Config) (<-chan uint16, <-chan uint16) {
+func StartFileSharing(ctx context.Context, g *dgroup.Group, config Config) (<-chan uint16, <-chan uint16, <-chan uint16) {
 	sftpPortCh := make(chan uint16)
 	ftpPortCh := make(chan uint16)
+	grpcFsPortCh := make(chan uint16)
 	if config.HasMounts(ctx) {
 		g.Go("sftp-server", func(ctx context.Context) error {
 			return sftpServer(ctx, sftpPortCh)
@@ -134,12 +135,16 @@ func StartFileSharing(ctx context.Context, g *dgroup.Group, config Config) (<-ch
 				return ftp.Start(ctx, config.PodIP(), agentconfig.ExportsMountPoint, ftpPortCh)
 			}
 		})
+		g.Go("grpc-fs-server", func(ctx context.Context) error {
+			return grpcFsServer(ctx, agentconfig.ExportsMountPoint, grpcFsPortCh)
+		})
 	} else {
 		close(sftpPortCh)
 		close(ftpPortCh)
+		close(grpcFsPortCh)
 		dlog.Info(ctx, "Not starting sftp-server because there's nothing to mount")
 	}
-	return sftpPortCh, ftpPortCh
+	return sftpPortCh, ftpPortCh, grpcFsPortCh
 }
 
 func Main(ctx context.Context, args ...string) error {
@@ -154,6 +159,7 @@ func Main(ctx context.Context, args ...string) error {
 	info := &rpc.AgentInfo{
 		Name:      config.AgentConfig().AgentName,
 		PodIp:     config.PodIP(),
+		PodName:   config.PodName(),
 		Product:   "telepresence",
 		Version:   version.Version,
 		Namespace: config.AgentConfig().Namespace,
@@ -166,6 +172,16 @@ func Main(ctx context.Context, args ...string) error {
 			Product: "telepresence",
 			Version: version.Version,
 		},
+		{
+			Name:    "http",
+			Product: "telepresence",
+			Version: version.Version,
+		},
+		{
+			Name:    "mirror",
+			Product: "telepresence",
+			Version: version.Version,
+		},
 	}
 	info.Mechanisms = mechanisms
 
@@ -184,7 +200,7 @@ func Main(ctx context.Context, args ...string) error {
 		defer tracer.Shutdown(ctx)
 	}
 
-	sftpPortCh, ftpPortCh := StartFileSharing(ctx, g, config)
+	sftpPortCh, ftpPortCh, grpcFsPortCh := StartFileSharing(ctx, g, config)
 
 	// Talk to the Traffic Manager
 	g.Go("client", func(ctx context.Context) error {
@@ -202,6 +218,17 @@ func Main(ctx context.Context, args ...string) error {
 		if err := state.WaitForSftpPort(ctx, sftpPortCh); err != nil {
 			return err
 		}
+		if err := state.WaitForGrpcFsPort(ctx, grpcFsPortCh); err != nil {
+			return err
+		}
+
+		terminatingTLS, originatingTLS, err := LoadTLSConfigs(ctx)
+		if err != nil {
+			return err
+		}
+		if terminatingTLS != nil {
+			dlog.Info(ctx, "TLS of intercepted connections will be terminated")
+		}
 
 		// Manage the forwarders
 		for _, cn := range ac.Containers {
@@ -224,6 +251,9 @@ func Main(ctx context.Context, args ...string) error {
 					return err
 				}
 				fwd := forwarder.NewInterceptor(lisAddr, "127.0.0.1", cp)
+				if tf, ok := fwd.(forwarder.TLSInterceptor); ok && terminatingTLS != nil {
+					tf.SetTLS(terminatingTLS, originatingTLS)
+				}
 				g.Go(fmt.Sprintf("forward-%s:%d", cn.Name, cp), func(ctx context.Context) error {
 					return fwd.Serve(tunnel.WithPool(ctx, tunnel.NewPool()), nil)
 				})
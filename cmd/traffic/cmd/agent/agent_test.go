@@ -2,6 +2,7 @@ package agent_test
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"io"
 	"path/filepath"
@@ -18,6 +19,7 @@ import (
 	"github.com/telepresenceio/telepresence/v2/pkg/agentconfig"
 	"github.com/telepresenceio/telepresence/v2/pkg/dos"
 	"github.com/telepresenceio/telepresence/v2/pkg/dos/aferofs"
+	"github.com/telepresenceio/telepresence/v2/pkg/install"
 )
 
 const (
@@ -126,3 +128,31 @@ func Test_AppEnvironment(t *testing.T) {
 	require.NoError(t, f.Close())
 	require.Equal(t, "default\n", string(data))
 }
+
+func Test_LoadTLSConfigs(t *testing.T) {
+	ctx := testContext(t, nil)
+	terminating, originating, err := agent.LoadTLSConfigs(ctx)
+	require.NoError(t, err)
+	require.Nil(t, terminating)
+	require.Nil(t, originating)
+
+	crtPEM, keyPEM, caPEM, err := install.GenerateKeys("ambassador")
+	require.NoError(t, err)
+	require.NoError(t, dos.MkdirAll(ctx, agentconfig.TerminatingTLSMountPoint, 0o700))
+	require.NoError(t, dos.WriteFile(ctx, filepath.Join(agentconfig.TerminatingTLSMountPoint, core.TLSCertKey), crtPEM, 0o600))
+	require.NoError(t, dos.WriteFile(ctx, filepath.Join(agentconfig.TerminatingTLSMountPoint, core.TLSPrivateKeyKey), keyPEM, 0o600))
+	require.NoError(t, dos.WriteFile(ctx, filepath.Join(agentconfig.TerminatingTLSMountPoint, "ca.crt"), caPEM, 0o600))
+	require.NoError(t, dos.MkdirAll(ctx, agentconfig.OriginatingTLSMountPoint, 0o700))
+	require.NoError(t, dos.WriteFile(ctx, filepath.Join(agentconfig.OriginatingTLSMountPoint, core.TLSCertKey), crtPEM, 0o600))
+	require.NoError(t, dos.WriteFile(ctx, filepath.Join(agentconfig.OriginatingTLSMountPoint, core.TLSPrivateKeyKey), keyPEM, 0o600))
+
+	terminating, originating, err = agent.LoadTLSConfigs(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, terminating)
+	require.Len(t, terminating.Certificates, 1)
+	require.NotNil(t, terminating.ClientCAs)
+	require.Equal(t, tls.VerifyClientCertIfGiven, terminating.ClientAuth)
+	require.NotNil(t, originating)
+	require.Len(t, originating.Certificates, 1)
+	require.Nil(t, originating.RootCAs)
+}
@@ -19,11 +19,13 @@ type Config interface {
 	AgentConfig() *agentconfig.Sidecar
 	HasMounts(ctx context.Context) bool
 	PodIP() string
+	PodName() string
 }
 
 type config struct {
 	agentconfig.Sidecar
-	podIP string
+	podIP   string
+	podName string
 }
 
 func LoadConfig(ctx context.Context) (Config, error) {
@@ -44,6 +46,7 @@ func LoadConfig(ctx context.Context) (Config, error) {
 		c.ManagerPort = 8081
 	}
 	c.podIP = dos.Getenv(ctx, "_TEL_AGENT_POD_IP")
+	c.podName = dos.Getenv(ctx, "_TEL_AGENT_NAME")
 	for _, cn := range c.Containers {
 		if err := addAppMounts(ctx, cn); err != nil {
 			return nil, err
@@ -72,6 +75,10 @@ func (c *config) PodIP() string {
 	return c.podIP
 }
 
+func (c *config) PodName() string {
+	return c.podName
+}
+
 func OtelResources(ctx context.Context, c Config) []attribute.KeyValue {
 	return []attribute.KeyValue{
 		attribute.Bool("tel2.has-mounts", c.HasMounts(ctx)),
@@ -0,0 +1,409 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"net"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/datawire/dlib/dlog"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/agent"
+	"github.com/telepresenceio/telepresence/v2/pkg/iputil"
+)
+
+const (
+	defaultChunkSize = 64 * 1024
+	maxChunkSize     = 1024 * 1024
+)
+
+// fileSystemServer serves the files in a directory using the FileSystem gRPC service.
+type fileSystemServer struct {
+	rpc.UnsafeFileSystemServer
+	root    string
+	watcher *fsnotify.Watcher
+
+	sync.Mutex
+	watched     map[string]struct{}
+	subscribers map[chan []string]struct{}
+}
+
+// errSubscriberTooSlow ends a WatchChanges stream that didn't keep up with the changes. The
+// client must then assume that anything may have changed.
+var errSubscriberTooSlow = status.Error(codes.ResourceExhausted, "too many unread changes") //nolint:gochecknoglobals // constant
+
+// grpcFsServer creates a listener on the next available port, writes that port on the given
+// channel, and then serves the FileSystem gRPC service for the given directory on that port.
+func grpcFsServer(ctx context.Context, root string, portCh chan<- uint16) error {
+	defer close(portCh)
+
+	lc := net.ListenConfig{}
+	l, err := lc.Listen(ctx, "tcp", ":0")
+	if err != nil {
+		return err
+	}
+	_, port, err := iputil.SplitToIPPort(l.Addr())
+	if err != nil {
+		_ = l.Close()
+		return err
+	}
+
+	fss, err := newFileSystemServer(root)
+	if err != nil {
+		_ = l.Close()
+		return err
+	}
+	defer fss.watcher.Close()
+	go fss.dispatchChanges(ctx)
+
+	svr := grpc.NewServer()
+	rpc.RegisterFileSystemServer(svr, fss)
+	go func() {
+		<-ctx.Done()
+		svr.Stop()
+	}()
+	portCh <- port
+	dlog.Debugf(ctx, "Serving gRPC file system on port %d", port)
+	if err = svr.Serve(l); err != nil && ctx.Err() == nil {
+		return err
+	}
+	return nil
+}
+
+func newFileSystemServer(root string) (*fileSystemServer, error) {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	return &fileSystemServer{
+		root:        root,
+		watcher:     w,
+		watched:     make(map[string]struct{}),
+		subscribers: make(map[chan []string]struct{}),
+	}, nil
+}
+
+// localPath returns the local path for the given slash separated path, which is relative
+// to the root. Paths that try to escape the root are rejected.
+func (s *fileSystemServer) localPath(p string) (string, error) {
+	cp := path.Clean("/" + p)
+	if strings.Contains(cp, "\x00") {
+		return "", status.Errorf(codes.InvalidArgument, "invalid path %q", p)
+	}
+	return filepath.Join(s.root, filepath.FromSlash(cp)), nil
+}
+
+// remotePath is the inverse of localPath.
+func (s *fileSystemServer) remotePath(lp string) string {
+	rp, err := filepath.Rel(s.root, lp)
+	if err != nil {
+		return "/"
+	}
+	return path.Clean("/" + filepath.ToSlash(rp))
+}
+
+// stat returns the info of the given file. Symbolic links are followed, unless they are
+// dangling, in which case the info of the link itself is returned.
+func stat(lp string) (fs.FileInfo, error) {
+	fi, err := os.Stat(lp)
+	if err != nil && errors.Is(err, fs.ErrNotExist) {
+		if lfi, lerr := os.Lstat(lp); lerr == nil {
+			return lfi, nil
+		}
+	}
+	return fi, err
+}
+
+func toFileInfo(fi fs.FileInfo) *rpc.FileInfo {
+	return &rpc.FileInfo{
+		Name:    fi.Name(),
+		Size:    fi.Size(),
+		Mode:    uint32(fi.Mode()),
+		ModTime: timestamppb.New(fi.ModTime()),
+	}
+}
+
+// toStatus translates errors from the os package into gRPC status errors, so that the
+// client can translate them back.
+func toStatus(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, fs.ErrNotExist):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, fs.ErrExist):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, fs.ErrPermission):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, fs.ErrInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Error(codes.Unknown, err.Error())
+	}
+}
+
+func (s *fileSystemServer) Stat(_ context.Context, req *rpc.PathRequest) (*rpc.FileInfo, error) {
+	lp, err := s.localPath(req.Path)
+	if err != nil {
+		return nil, err
+	}
+	fi, err := stat(lp)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toFileInfo(fi), nil
+}
+
+func (s *fileSystemServer) ReadDir(ctx context.Context, req *rpc.PathRequest) (*rpc.DirEntries, error) {
+	lp, err := s.localPath(req.Path)
+	if err != nil {
+		return nil, err
+	}
+	des, err := os.ReadDir(lp)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.watch(ctx, lp)
+	entries := make([]*rpc.FileInfo, 0, len(des))
+	for _, de := range des {
+		fi, err := stat(filepath.Join(lp, de.Name()))
+		if err != nil {
+			// Removed after the directory was read.
+			continue
+		}
+		entries = append(entries, toFileInfo(fi))
+	}
+	return &rpc.DirEntries{Entries: entries}, nil
+}
+
+func (s *fileSystemServer) Read(req *rpc.ReadRequest, stream rpc.FileSystem_ReadServer) error {
+	lp, err := s.localPath(req.Path)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(lp)
+	if err != nil {
+		return toStatus(err)
+	}
+	defer f.Close()
+
+	chunkSize := int(req.ChunkSize)
+	switch {
+	case chunkSize <= 0:
+		chunkSize = defaultChunkSize
+	case chunkSize > maxChunkSize:
+		chunkSize = maxChunkSize
+	}
+	var r io.Reader = io.NewSectionReader(f, req.Offset, 1<<62)
+	if req.Length > 0 {
+		r = io.LimitReader(r, req.Length)
+	}
+	buf := make([]byte, chunkSize)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			if serr := stream.Send(&rpc.Chunk{Data: buf[:n]}); serr != nil {
+				return serr
+			}
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil
+			}
+			return toStatus(err)
+		}
+	}
+}
+
+func (s *fileSystemServer) Write(_ context.Context, req *rpc.WriteRequest) (*emptypb.Empty, error) {
+	lp, err := s.localPath(req.Path)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(lp, os.O_WRONLY, 0)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	_, err = f.WriteAt(req.Data, req.Offset)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return &emptypb.Empty{}, toStatus(err)
+}
+
+func (s *fileSystemServer) Create(_ context.Context, req *rpc.CreateRequest) (*rpc.FileInfo, error) {
+	lp, err := s.localPath(req.Path)
+	if err != nil {
+		return nil, err
+	}
+	flags := os.O_RDWR | os.O_CREATE | os.O_TRUNC
+	if req.Exclusive {
+		flags |= os.O_EXCL
+	}
+	f, err := os.OpenFile(lp, flags, fs.FileMode(req.Mode).Perm())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toFileInfo(fi), nil
+}
+
+func (s *fileSystemServer) Truncate(_ context.Context, req *rpc.TruncateRequest) (*emptypb.Empty, error) {
+	lp, err := s.localPath(req.Path)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, toStatus(os.Truncate(lp, req.Size))
+}
+
+func (s *fileSystemServer) Mkdir(_ context.Context, req *rpc.CreateRequest) (*emptypb.Empty, error) {
+	lp, err := s.localPath(req.Path)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, toStatus(os.Mkdir(lp, fs.FileMode(req.Mode).Perm()))
+}
+
+func (s *fileSystemServer) Remove(_ context.Context, req *rpc.RemoveRequest) (*emptypb.Empty, error) {
+	lp, err := s.localPath(req.Path)
+	if err != nil {
+		return nil, err
+	}
+	if lp == s.root {
+		return nil, status.Error(codes.InvalidArgument, "the root directory cannot be removed")
+	}
+	if req.Recursive {
+		err = os.RemoveAll(lp)
+	} else {
+		err = os.Remove(lp)
+	}
+	return &emptypb.Empty{}, toStatus(err)
+}
+
+func (s *fileSystemServer) Rename(_ context.Context, req *rpc.RenameRequest) (*emptypb.Empty, error) {
+	oldPath, err := s.localPath(req.OldPath)
+	if err != nil {
+		return nil, err
+	}
+	newPath, err := s.localPath(req.NewPath)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, toStatus(os.Rename(oldPath, newPath))
+}
+
+func (s *fileSystemServer) Chmod(_ context.Context, req *rpc.ChmodRequest) (*emptypb.Empty, error) {
+	lp, err := s.localPath(req.Path)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, toStatus(os.Chmod(lp, fs.FileMode(req.Mode)))
+}
+
+func (s *fileSystemServer) Chtimes(_ context.Context, req *rpc.ChtimesRequest) (*emptypb.Empty, error) {
+	lp, err := s.localPath(req.Path)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, toStatus(os.Chtimes(lp, req.AccessTime.AsTime(), req.ModTime.AsTime()))
+}
+
+func (s *fileSystemServer) WatchChanges(_ *emptypb.Empty, stream rpc.FileSystem_WatchChangesServer) error {
+	ch := make(chan []string, 256)
+	s.Lock()
+	s.subscribers[ch] = struct{}{}
+	s.Unlock()
+	defer func() {
+		s.Lock()
+		if _, ok := s.subscribers[ch]; ok {
+			delete(s.subscribers, ch)
+			close(ch)
+		}
+		s.Unlock()
+	}()
+
+	ctx := stream.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case paths, ok := <-ch:
+			if !ok {
+				return errSubscriberTooSlow
+			}
+			if err := stream.Send(&rpc.Changes{Paths: paths}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// watch adds a watcher for the given directory unless it is already watched.
+func (s *fileSystemServer) watch(ctx context.Context, dir string) {
+	s.Lock()
+	defer s.Unlock()
+	if _, ok := s.watched[dir]; ok {
+		return
+	}
+	if err := s.watcher.Add(dir); err != nil {
+		dlog.Debugf(ctx, "unable to watch %s: %v", dir, err)
+		return
+	}
+	s.watched[dir] = struct{}{}
+}
+
+// dispatchChanges sends the paths of the changes reported by the watcher to all subscribers. A
+// change to a file is also reported as a change to its directory. The channel of a subscriber that
+// doesn't keep up is closed, because a subscriber that misses a change cannot trust its cache.
+func (s *fileSystemServer) dispatchChanges(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case err, ok := <-s.watcher.Errors:
+			if !ok {
+				return
+			}
+			dlog.Errorf(ctx, "file system watcher: %v", err)
+		case ev, ok := <-s.watcher.Events:
+			if !ok {
+				return
+			}
+			if ev.Has(fsnotify.Remove) || ev.Has(fsnotify.Rename) {
+				s.Lock()
+				delete(s.watched, ev.Name)
+				s.Unlock()
+			}
+			paths := []string{s.remotePath(ev.Name), s.remotePath(filepath.Dir(ev.Name))}
+			s.Lock()
+			for ch := range s.subscribers {
+				select {
+				case ch <- paths:
+				default:
+					delete(s.subscribers, ch)
+					close(ch)
+				}
+			}
+			s.Unlock()
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/datawire/dlib/dlog"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/agent"
+)
+
+func startFileSystemServer(t *testing.T, root string) rpc.FileSystemClient {
+	ctx, cancel := context.WithCancel(dlog.NewTestContext(t, false))
+	portCh := make(chan uint16)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- grpcFsServer(ctx, root, portCh)
+	}()
+	port, ok := <-portCh
+	require.True(t, ok, "server failed to start")
+	conn, err := grpc.DialContext(ctx, fmt.Sprintf("127.0.0.1:%d", port), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+		cancel()
+		assert.NoError(t, <-errCh)
+	})
+	return rpc.NewFileSystemClient(conn)
+}
+
+func readAll(t *testing.T, c rpc.FileSystemClient, req *rpc.ReadRequest) string {
+	stream, err := c.Read(context.Background(), req)
+	require.NoError(t, err)
+	var data []byte
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return string(data)
+		}
+		require.NoError(t, err)
+		data = append(data, chunk.Data...)
+	}
+}
+
+func TestFileSystemServer(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "app", "config"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "app", "config", "settings.yaml"), []byte("0123456789"), 0o644))
+	c := startFileSystemServer(t, root)
+	ctx := context.Background()
+
+	fi, err := c.Stat(ctx, &rpc.PathRequest{Path: "/app/config/settings.yaml"})
+	require.NoError(t, err)
+	assert.Equal(t, "settings.yaml", fi.Name)
+	assert.Equal(t, int64(10), fi.Size)
+
+	_, err = c.Stat(ctx, &rpc.PathRequest{Path: "/app/missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Paths cannot escape the root.
+	fi, err = c.Stat(ctx, &rpc.PathRequest{Path: "/../../app"})
+	require.NoError(t, err)
+	assert.True(t, os.FileMode(fi.Mode).IsDir())
+
+	des, err := c.ReadDir(ctx, &rpc.PathRequest{Path: "/app"})
+	require.NoError(t, err)
+	require.Len(t, des.Entries, 1)
+	assert.Equal(t, "config", des.Entries[0].Name)
+
+	assert.Equal(t, "0123456789", readAll(t, c, &rpc.ReadRequest{Path: "/app/config/settings.yaml", ChunkSize: 3}))
+	assert.Equal(t, "2345", readAll(t, c, &rpc.ReadRequest{Path: "/app/config/settings.yaml", Offset: 2, Length: 4}))
+
+	_, err = c.Create(ctx, &rpc.CreateRequest{Path: "/app/config/settings.yaml", Mode: 0o644, Exclusive: true})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = c.Create(ctx, &rpc.CreateRequest{Path: "/app/new.txt", Mode: 0o644})
+	require.NoError(t, err)
+	_, err = c.Write(ctx, &rpc.WriteRequest{Path: "/app/new.txt", Offset: 0, Data: []byte("hello")})
+	require.NoError(t, err)
+	_, err = c.Rename(ctx, &rpc.RenameRequest{OldPath: "/app/new.txt", NewPath: "/app/hello.txt"})
+	require.NoError(t, err)
+	data, err := os.ReadFile(filepath.Join(root, "app", "hello.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(data))
+
+	_, err = c.Remove(ctx, &rpc.RemoveRequest{Path: "/app/config"})
+	assert.Error(t, err)
+	_, err = c.Remove(ctx, &rpc.RemoveRequest{Path: "/app/config", Recursive: true})
+	require.NoError(t, err)
+	_, err = c.Remove(ctx, &rpc.RemoveRequest{Path: "/", Recursive: true})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestFileSystemServer_WatchChanges(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(root, "app"), 0o755))
+	c := startFileSystemServer(t, root)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := c.WatchChanges(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+
+	// Changes are only reported for directories that have been read.
+	_, err = c.ReadDir(ctx, &rpc.PathRequest{Path: "/app"})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(root, "app", "index.js"), []byte("x"), 0o644))
+
+	changes := make(chan []string)
+	go func() {
+		for {
+			cs, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			changes <- cs.Paths
+		}
+	}()
+	select {
+	case paths := <-changes:
+		assert.Equal(t, []string{"/app/index.js", "/app"}, paths)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no change reported")
+	}
+}
@@ -14,14 +14,17 @@ import (
 
 type fwdState struct {
 	*simpleState
-	intercepts []*agentconfig.Intercept
-	forwarder  forwarder.Interceptor
-	mountPoint string
-	env        map[string]string
+	intercepts     []*agentconfig.Intercept
+	forwarder      forwarder.Interceptor
+	mountPoint     string
+	env            map[string]string
+	httpIntercepts []*forwarder.HTTPIntercept
 }
 
-// NewInterceptState creates a InterceptState that performs intercepts by using an Interceptor which indiscriminately
-// intercepts all traffic to the port that it forwards.
+// NewInterceptState creates a InterceptState that performs intercepts by using an Interceptor which either
+// indiscriminately intercepts all traffic to the port that it forwards, or routes individual HTTP requests
+// based on the headers and path of the request. The Interceptor can also mirror the traffic to clients
+// without diverting it.
 func NewInterceptState(s State, forwarder forwarder.Interceptor, intercepts []*agentconfig.Intercept, mountPoint string, env map[string]string) InterceptState {
 	return &fwdState{
 		simpleState: s.(*simpleState),
@@ -37,24 +40,86 @@ func (fs *fwdState) InterceptConfigs() []*agentconfig.Intercept {
 }
 
 func (fs *fwdState) InterceptInfo(ctx context.Context, callerID, path string, containerPort uint16, headers http.Header) (*restapi.InterceptInfo, error) {
-	// The OSS agent is either intercepting or it isn't. There's no way to tell what it is that's being intercepted.
 	fw := fs.forwarder
-	if containerPort == 0 {
-		return fw.InterceptInfo(), nil
-	}
-	_, port := fw.Target()
-	if containerPort == port {
-		return fw.InterceptInfo(), nil
-	}
-	portInfo := ""
 	if containerPort != 0 {
-		portInfo = fmt.Sprintf(", port %d", containerPort)
+		if _, port := fw.Target(); containerPort != port {
+			dlog.Debugf(ctx, "no match found for path %q, port %d, %s", path, containerPort, headers)
+			return &restapi.InterceptInfo{Intercepted: false}, nil
+		}
+	}
+	for _, ic := range fs.httpIntercepts {
+		if ic.Disposition != manager.InterceptDispositionType_DRAINING && ic.Request.Matches(path, headers) {
+			return &restapi.InterceptInfo{Intercepted: true, Metadata: ic.Metadata}, nil
+		}
 	}
-	dlog.Debugf(ctx, "no match found for path %q%s, %s", path, portInfo, headers)
-	return &restapi.InterceptInfo{Intercepted: false}, nil
+	return fw.InterceptInfo(), nil
 }
 
 func (fs *fwdState) HandleIntercepts(ctx context.Context, cepts []*manager.InterceptInfo) []*manager.ReviewInterceptRequest {
+	var tcpCepts, httpCepts, mirrorCepts []*manager.InterceptInfo
+	for _, cept := range cepts {
+		switch {
+		case cept.Spec.Mirror:
+			mirrorCepts = append(mirrorCepts, cept)
+		case cept.Spec.Mechanism == "http":
+			httpCepts = append(httpCepts, cept)
+		default:
+			tcpCepts = append(tcpCepts, cept)
+		}
+	}
+
+	// Update forwarding.
+	fs.forwarder.SetManager(fs.SessionInfo(), fs.ManagerClient(), fs.ManagerVersion())
+	reviews := fs.handleTCPIntercepts(ctx, tcpCepts)
+	reviews = append(reviews, fs.handleHTTPIntercepts(ctx, httpCepts)...)
+	return append(reviews, fs.handleMirrorIntercepts(ctx, mirrorCepts)...)
+}
+
+func (fs *fwdState) activeReview(cept *manager.InterceptInfo, desc string) *manager.ReviewInterceptRequest {
+	return &manager.ReviewInterceptRequest{
+		Id:                cept.Id,
+		Disposition:       manager.InterceptDispositionType_ACTIVE,
+		PodIp:             fs.PodIP(),
+		FtpPort:           int32(fs.FtpPort()),
+		SftpPort:          int32(fs.SftpPort()),
+		GrpcFsPort:        int32(fs.GrpcFsPort()),
+		MountPoint:        fs.mountPoint,
+		MechanismArgsDesc: desc,
+		Environment:       fs.env,
+	}
+}
+
+func (fs *fwdState) conflictReview(ctx context.Context, cept *manager.InterceptInfo, desc string) *manager.ReviewInterceptRequest {
+	var chosenID string
+	var active bool
+	if fs.chosenIntercept != nil {
+		chosenID = fs.chosenIntercept.Id
+		active = fs.chosenIntercept.Disposition == manager.InterceptDispositionType_ACTIVE
+	} else {
+		// Conflicts with a http intercept. Pick the one with the lowest ID to get a consistent message
+		for id, hc := range fs.simpleState.httpIntercepts {
+			if chosenID == "" || id < chosenID {
+				chosenID = id
+				active = hc.Disposition == manager.InterceptDispositionType_ACTIVE
+			}
+		}
+	}
+	dlog.Infof(ctx, "Setting intercept %q as AGENT_ERROR; as it conflicts with %q as the current chosen-to-be-ACTIVE intercept", cept.Id, chosenID)
+	var msg string
+	if active {
+		msg = fmt.Sprintf("Conflicts with the currently-served intercept %q", chosenID)
+	} else {
+		msg = fmt.Sprintf("Conflicts with the currently-waiting-to-be-served intercept %q", chosenID)
+	}
+	return &manager.ReviewInterceptRequest{
+		Id:                cept.Id,
+		Disposition:       manager.InterceptDispositionType_AGENT_ERROR,
+		Message:           msg,
+		MechanismArgsDesc: desc,
+	}
+}
+
+func (fs *fwdState) handleTCPIntercepts(ctx context.Context, cepts []*manager.InterceptInfo) []*manager.ReviewInterceptRequest {
 	var myChoice, activeIntercept *manager.InterceptInfo
 
 	// Find the chosen intercept if it still exists
@@ -66,14 +131,15 @@ func (fs *fwdState) HandleIntercepts(ctx context.Context, cepts []*manager.Inter
 			}
 		}
 
-		if myChoice != nil && myChoice.Disposition == manager.InterceptDispositionType_ACTIVE {
-			// The chosen intercept still exists and is active
+		if myChoice != nil && (myChoice.Disposition == manager.InterceptDispositionType_ACTIVE ||
+			myChoice.Disposition == manager.InterceptDispositionType_DRAINING) {
+			// The chosen intercept still exists and is active, or its connections are draining
 			activeIntercept = myChoice
 		}
-	} else {
-		// Attach to already ACTIVE intercept if there is one.
+	} else if len(fs.simpleState.httpIntercepts) == 0 {
+		// Attach to already ACTIVE or DRAINING intercept if there is one.
 		for _, cept := range cepts {
-			if cept.Disposition == manager.InterceptDispositionType_ACTIVE {
+			if cept.Disposition == manager.InterceptDispositionType_ACTIVE || cept.Disposition == manager.InterceptDispositionType_DRAINING {
 				myChoice = cept
 				fs.chosenIntercept = cept
 				activeIntercept = cept
@@ -82,8 +148,6 @@ func (fs *fwdState) HandleIntercepts(ctx context.Context, cepts []*manager.Inter
 		}
 	}
 
-	// Update forwarding.
-	fs.forwarder.SetManager(fs.SessionInfo(), fs.ManagerClient(), fs.ManagerVersion())
 	fs.forwarder.SetIntercepting(activeIntercept)
 
 	// Review waiting intercepts
@@ -96,17 +160,8 @@ func (fs *fwdState) HandleIntercepts(ctx context.Context, cepts []*manager.Inter
 				// We've already chosen this one, but it's not active yet in this
 				// snapshot. Let's go ahead and tell the manager to mark it ACTIVE.
 				dlog.Infof(ctx, "Setting intercept %q as ACTIVE (again?)", cept.Id)
-				reviews = append(reviews, &manager.ReviewInterceptRequest{
-					Id:                cept.Id,
-					Disposition:       manager.InterceptDispositionType_ACTIVE,
-					PodIp:             fs.PodIP(),
-					FtpPort:           int32(fs.FtpPort()),
-					SftpPort:          int32(fs.SftpPort()),
-					MountPoint:        fs.mountPoint,
-					MechanismArgsDesc: "all TCP connections",
-					Environment:       fs.env,
-				})
-			case fs.chosenIntercept == nil:
+				reviews = append(reviews, fs.activeReview(cept, "all TCP connections"))
+			case fs.chosenIntercept == nil && len(fs.simpleState.httpIntercepts) == 0:
 				// We don't have an intercept in play, so choose this one. All
 				// agents will get intercepts in the same order every time, so
 				// this will yield a consistent result. Note that the intercept
@@ -115,34 +170,103 @@ func (fs *fwdState) HandleIntercepts(ctx context.Context, cepts []*manager.Inter
 				dlog.Infof(ctx, "Setting intercept %q as ACTIVE", cept.Id)
 				fs.chosenIntercept = cept
 				myChoice = cept
+				reviews = append(reviews, fs.activeReview(cept, "all TCP connections"))
+			default:
+				// We already have an intercept in play, so reject this one.
+				reviews = append(reviews, fs.conflictReview(ctx, cept, "all TCP connections"))
+			}
+		}
+	}
+	return reviews
+}
+
+// handleHTTPIntercepts reviews intercepts that use the "http" mechanism. Any number of such intercepts can be
+// active at the same time. The forwarder will route each request to the first intercept that matches it.
+func (fs *fwdState) handleHTTPIntercepts(ctx context.Context, cepts []*manager.InterceptInfo) []*manager.ReviewInterceptRequest {
+	hf, isHTTP := fs.forwarder.(forwarder.HTTPInterceptor)
+	var active []*forwarder.HTTPIntercept
+	reviews := []*manager.ReviewInterceptRequest{}
+	for _, cept := range cepts {
+		waiting := cept.Disposition == manager.InterceptDispositionType_WAITING
+		if !(waiting || cept.Disposition == manager.InterceptDispositionType_ACTIVE || cept.Disposition == manager.InterceptDispositionType_DRAINING) {
+			continue
+		}
+		rq, err := parseHTTPMechanismArgs(cept.Spec.MechanismArgs)
+		if err != nil {
+			if waiting {
+				dlog.Infof(ctx, "Setting intercept %q as BAD_ARGS: %v", cept.Id, err)
+				reviews = append(reviews, &manager.ReviewInterceptRequest{
+					Id:          cept.Id,
+					Disposition: manager.InterceptDispositionType_BAD_ARGS,
+					Message:     err.Error(),
+				})
+			}
+			continue
+		}
+		desc := rq.String()
+		switch {
+		case !isHTTP:
+			if waiting {
+				dlog.Infof(ctx, "Setting intercept %q as AGENT_ERROR; the http mechanism requires a TCP port", cept.Id)
 				reviews = append(reviews, &manager.ReviewInterceptRequest{
 					Id:                cept.Id,
-					Disposition:       manager.InterceptDispositionType_ACTIVE,
-					PodIp:             fs.PodIP(),
-					FtpPort:           int32(fs.FtpPort()),
-					SftpPort:          int32(fs.SftpPort()),
-					MountPoint:        fs.mountPoint,
-					MechanismArgsDesc: "all TCP connections",
-					Environment:       fs.env,
+					Disposition:       manager.InterceptDispositionType_AGENT_ERROR,
+					Message:           "The http mechanism can only be used with TCP ports",
+					MechanismArgsDesc: desc,
 				})
-			default:
-				// We already have an intercept in play, so reject this one.
-				chosenID := fs.chosenIntercept.Id
-				dlog.Infof(ctx, "Setting intercept %q as AGENT_ERROR; as it conflicts with %q as the current chosen-to-be-ACTIVE intercept", cept.Id, chosenID)
-				var msg string
-				if fs.chosenIntercept.Disposition == manager.InterceptDispositionType_ACTIVE {
-					msg = fmt.Sprintf("Conflicts with the currently-served intercept %q", chosenID)
-				} else {
-					msg = fmt.Sprintf("Conflicts with the currently-waiting-to-be-served intercept %q", chosenID)
-				}
+			}
+		case fs.chosenIntercept != nil:
+			if waiting {
+				reviews = append(reviews, fs.conflictReview(ctx, cept, desc))
+			}
+		case waiting:
+			if _, ok := fs.simpleState.httpIntercepts[cept.Id]; ok {
+				dlog.Infof(ctx, "Setting intercept %q as ACTIVE (again?)", cept.Id)
+			} else {
+				dlog.Infof(ctx, "Setting intercept %q as ACTIVE", cept.Id)
+			}
+			fs.simpleState.httpIntercepts[cept.Id] = cept
+			reviews = append(reviews, fs.activeReview(cept, desc))
+		default:
+			fs.simpleState.httpIntercepts[cept.Id] = cept
+			active = append(active, &forwarder.HTTPIntercept{InterceptInfo: cept, Request: rq})
+		}
+	}
+	fs.httpIntercepts = active
+	if isHTTP {
+		hf.SetHTTPIntercepting(active)
+	}
+	return reviews
+}
+
+// handleMirrorIntercepts reviews intercepts that mirror the traffic. Such intercepts never conflict with other
+// intercepts, because the traffic is still served by the intercepted container.
+func (fs *fwdState) handleMirrorIntercepts(ctx context.Context, cepts []*manager.InterceptInfo) []*manager.ReviewInterceptRequest {
+	const desc = "a copy of all TCP connections"
+	mf, isMirror := fs.forwarder.(forwarder.MirrorInterceptor)
+	var active []*manager.InterceptInfo
+	reviews := []*manager.ReviewInterceptRequest{}
+	for _, cept := range cepts {
+		switch cept.Disposition {
+		case manager.InterceptDispositionType_WAITING:
+			if isMirror {
+				dlog.Infof(ctx, "Setting intercept %q as ACTIVE", cept.Id)
+				reviews = append(reviews, fs.activeReview(cept, desc))
+			} else {
+				dlog.Infof(ctx, "Setting intercept %q as AGENT_ERROR; mirroring requires a TCP port", cept.Id)
 				reviews = append(reviews, &manager.ReviewInterceptRequest{
 					Id:                cept.Id,
 					Disposition:       manager.InterceptDispositionType_AGENT_ERROR,
-					Message:           msg,
-					MechanismArgsDesc: "all TCP connections",
+					Message:           "Traffic can only be mirrored from TCP ports",
+					MechanismArgsDesc: desc,
 				})
 			}
+		case manager.InterceptDispositionType_ACTIVE:
+			active = append(active, cept)
 		}
 	}
+	if isMirror {
+		mf.SetMirroring(active)
+	}
 	return reviews
 }
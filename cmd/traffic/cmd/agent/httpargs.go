@@ -0,0 +1,67 @@
+package agent
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"github.com/telepresenceio/telepresence/v2/pkg/matcher"
+)
+
+// parseHTTPMechanismArgs parses the mechanism_args of an intercept that uses the "http" mechanism
+// and returns the matcher.Request that decides which requests that are routed to the intercept. The
+// arguments are:
+//
+//	--http-header=KEY=VALUE  (repeatable) header KEY must match VALUE
+//	--http-path-equal=PATH   the request path must be equal to PATH
+//	--http-path-prefix=PATH  the request path must be prefixed by PATH
+//	--http-path-regex=REGEX  the request path must match REGEX
+//
+// A VALUE containing regular expression meta characters is treated as a regular expression.
+func parseHTTPMechanismArgs(args []string) (matcher.Request, error) {
+	var (
+		headers    []string
+		pathEqual  string
+		pathPrefix string
+		pathRegex  string
+	)
+	flags := pflag.NewFlagSet("http", pflag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	flags.StringArrayVar(&headers, "http-header", nil, "")
+	flags.StringVar(&pathEqual, "http-path-equal", "", "")
+	flags.StringVar(&pathPrefix, "http-path-prefix", "", "")
+	flags.StringVar(&pathRegex, "http-path-regex", "", "")
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+	if flags.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected argument %q", flags.Arg(0))
+	}
+
+	m := make(map[string]string, len(headers)+1)
+	for _, h := range headers {
+		k, v, ok := strings.Cut(h, "=")
+		if !ok || k == "" {
+			return nil, fmt.Errorf("invalid --http-header %q, expected KEY=VALUE", h)
+		}
+		m[k] = v
+	}
+
+	pathArgs := 0
+	for k, v := range map[string]string{
+		":path-equal:":  pathEqual,
+		":path-prefix:": pathPrefix,
+		":path-regex:":  pathRegex,
+	} {
+		if v != "" {
+			m[k] = v
+			pathArgs++
+		}
+	}
+	if pathArgs > 1 {
+		return nil, fmt.Errorf("only one of --http-path-equal, --http-path-prefix, and --http-path-regex can be used")
+	}
+	return matcher.NewRequestFromMap(m)
+}
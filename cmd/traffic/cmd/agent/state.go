@@ -27,6 +27,8 @@ type State interface {
 	SftpPort() uint16
 	WaitForFtpPort(ctx context.Context, ch <-chan uint16) error
 	WaitForSftpPort(ctx context.Context, ch <-chan uint16) error
+	GrpcFsPort() uint16
+	WaitForGrpcFsPort(ctx context.Context, ch <-chan uint16) error
 }
 
 // An InterceptState implements what's needed to intercept one port.
@@ -40,8 +42,9 @@ type InterceptState interface {
 // State of the Traffic Agent.
 type state struct {
 	Config
-	ftpPort  uint16
-	sftpPort uint16
+	ftpPort    uint16
+	sftpPort   uint16
+	grpcFsPort uint16
 
 	// The sessionInfo and manager client are needed when forwarders establish their
 	// tunnel to the traffic-manager.
@@ -55,6 +58,10 @@ type state struct {
 type simpleState struct {
 	state
 	chosenIntercept *manager.InterceptInfo
+
+	// httpIntercepts are the intercepts using the "http" mechanism that have been chosen to be ACTIVE.
+	// Several http intercepts can be active at the same time, but not together with a chosenIntercept.
+	httpIntercepts map[string]*manager.InterceptInfo
 }
 
 func (s *state) ManagerClient() manager.ManagerClient {
@@ -74,7 +81,7 @@ func NewState(config Config) State {
 }
 
 func NewSimpleState(config Config) State {
-	return &simpleState{state: state{Config: config}}
+	return &simpleState{state: state{Config: config}, httpIntercepts: make(map[string]*manager.InterceptInfo)}
 }
 
 func (s *state) AddInterceptState(is InterceptState) {
@@ -124,6 +131,19 @@ func (s *simpleState) HandleIntercepts(ctx context.Context, iis []*manager.Inter
 			s.chosenIntercept = nil
 		}
 	}
+	for id := range s.httpIntercepts {
+		found := false
+		for _, is := range iis {
+			if id == is.Id {
+				found = true
+				s.httpIntercepts[id] = is
+				break
+			}
+		}
+		if !found {
+			delete(s.httpIntercepts, id)
+		}
+	}
 	return s.state.HandleIntercepts(ctx, iis)
 }
 
@@ -168,3 +188,16 @@ func (s *state) WaitForSftpPort(ctx context.Context, ch <-chan uint16) error {
 		return nil
 	}
 }
+
+func (s *state) GrpcFsPort() uint16 {
+	return s.grpcFsPort
+}
+
+func (s *state) WaitForGrpcFsPort(ctx context.Context, ch <-chan uint16) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case s.grpcFsPort = <-ch:
+		return nil
+	}
+}
@@ -3,6 +3,7 @@ package agent_test
 import (
 	"context"
 	"net"
+	"net/http"
 	"path/filepath"
 	"testing"
 	"time"
@@ -150,3 +151,128 @@ func TestState_HandleIntercepts(t *testing.T) {
 	a.Len(reviews, 0)
 	a.Equal("", f.InterceptId())
 }
+
+func TestState_HandleHTTPIntercepts(t *testing.T) {
+	ctx := testContext(t, nil)
+	a := assert.New(t)
+	_, s := makeFS(t, ctx)
+
+	httpCept := func(id, client string, args ...string) *rpc.InterceptInfo {
+		return &rpc.InterceptInfo{
+			Spec: &rpc.InterceptSpec{
+				Name:                  id + "Name",
+				Client:                client,
+				Agent:                 "agentName",
+				Mechanism:             "http",
+				MechanismArgs:         args,
+				Namespace:             namespace,
+				ServiceName:           serviceName,
+				ServicePortIdentifier: "http",
+				TargetPort:            8080,
+			},
+			Id:          id,
+			Disposition: rpc.InterceptDispositionType_WAITING,
+		}
+	}
+
+	cepts := []*rpc.InterceptInfo{
+		httpCept("intercept-01", "user@host1", "--http-header=x-dev=alice"),
+		httpCept("intercept-02", "user@host2", "--http-header=x-dev=bob", "--http-path-prefix=/api/"),
+		httpCept("intercept-03", "user@host3", "--http-header=x-dev"),
+	}
+
+	// Several http intercepts can be active at the same time, bad args are rejected
+
+	reviews := s.HandleIntercepts(ctx, cepts)
+	require.Len(t, reviews, 3)
+	a.Equal(rpc.InterceptDispositionType_ACTIVE, reviews[0].Disposition)
+	a.Equal("requests with headers\n  'X-Dev: alice'", reviews[0].MechanismArgsDesc)
+	a.Equal(rpc.InterceptDispositionType_ACTIVE, reviews[1].Disposition)
+	a.Equal(rpc.InterceptDispositionType_BAD_ARGS, reviews[2].Disposition)
+
+	// A tcp intercept conflicts with the http intercepts
+
+	cepts[0].Disposition = rpc.InterceptDispositionType_ACTIVE
+	cepts[1].Disposition = rpc.InterceptDispositionType_ACTIVE
+	cepts[2] = &rpc.InterceptInfo{
+		Spec: &rpc.InterceptSpec{
+			Name:                  "cept3Name",
+			Client:                "user@host3",
+			Agent:                 "agentName",
+			Mechanism:             "tcp",
+			Namespace:             namespace,
+			ServiceName:           serviceName,
+			ServicePortIdentifier: "http",
+			TargetPort:            8080,
+		},
+		Id:          "intercept-03",
+		Disposition: rpc.InterceptDispositionType_WAITING,
+	}
+	reviews = s.HandleIntercepts(ctx, cepts)
+	require.Len(t, reviews, 1)
+	a.Equal(rpc.InterceptDispositionType_AGENT_ERROR, reviews[0].Disposition)
+	a.Equal("Conflicts with the currently-served intercept \"intercept-01\"", reviews[0].Message)
+
+	// The REST API reports intercepts based on the request headers and path
+
+	ii, err := s.AgentState().InterceptInfo(ctx, "", "/api/x", 0, http.Header{"X-Dev": []string{"bob"}})
+	require.NoError(t, err)
+	a.True(ii.Intercepted)
+	ii, err = s.AgentState().InterceptInfo(ctx, "", "/other", 0, http.Header{"X-Dev": []string{"bob"}})
+	require.NoError(t, err)
+	a.False(ii.Intercepted)
+
+	// The tcp intercept can be chosen once the http intercepts are gone
+
+	reviews = s.HandleIntercepts(ctx, cepts[2:])
+	require.Len(t, reviews, 1)
+	a.Equal(rpc.InterceptDispositionType_ACTIVE, reviews[0].Disposition)
+}
+
+func TestState_HandleMirrorIntercepts(t *testing.T) {
+	ctx := testContext(t, nil)
+	a := assert.New(t)
+	_, s := makeFS(t, ctx)
+
+	cept := func(id, client string, mirror bool) *rpc.InterceptInfo {
+		return &rpc.InterceptInfo{
+			Spec: &rpc.InterceptSpec{
+				Name:                  id + "Name",
+				Client:                client,
+				Agent:                 "agentName",
+				Mechanism:             "tcp",
+				Mirror:                mirror,
+				Namespace:             namespace,
+				ServiceName:           serviceName,
+				ServicePortIdentifier: "http",
+				TargetPort:            8080,
+			},
+			Id:          id,
+			Disposition: rpc.InterceptDispositionType_WAITING,
+		}
+	}
+
+	cepts := []*rpc.InterceptInfo{
+		cept("intercept-01", "user@host1", true),
+		cept("intercept-02", "user@host2", true),
+		cept("intercept-03", "user@host3", false),
+	}
+
+	// Mirrors don't conflict with each other, nor with an intercept that diverts the traffic
+	reviews := s.HandleIntercepts(ctx, cepts)
+	require.Len(t, reviews, 3)
+	for _, r := range reviews {
+		a.Equal(rpc.InterceptDispositionType_ACTIVE, r.Disposition)
+	}
+	a.Equal("all TCP connections", reviews[0].MechanismArgsDesc)
+	a.Equal("a copy of all TCP connections", reviews[1].MechanismArgsDesc)
+
+	// Mirroring doesn't make the REST API report the pod as intercepted
+	cepts[0].Disposition = rpc.InterceptDispositionType_ACTIVE
+	cepts[1].Disposition = rpc.InterceptDispositionType_ACTIVE
+	reviews = s.HandleIntercepts(ctx, cepts[:2])
+	a.Empty(reviews)
+	ii, err := s.AgentState().InterceptInfo(ctx, "", "/", 0, nil)
+	require.NoError(t, err)
+	a.False(ii.Intercepted)
+}
@@ -0,0 +1,79 @@
+package agent
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	core "k8s.io/api/core/v1"
+
+	"github.com/telepresenceio/telepresence/v2/pkg/agentconfig"
+	"github.com/telepresenceio/telepresence/v2/pkg/dos"
+)
+
+// tlsCAKey is the key of the optional CA certificate in a TLS secret.
+const tlsCAKey = "ca.crt"
+
+// LoadTLSConfigs returns the TLS configs that the forwarders use to terminate the TLS of intercepted connections,
+// and to originate TLS when they send the cleartext to the app container. The configs are created from the
+// secrets that are mounted when the pod is annotated with agentconfig.TerminatingTLSSecretAnnotation and
+// agentconfig.OriginatingTLSSecretAnnotation. A config is nil when its secret isn't mounted.
+//
+// The CA of the terminating secret verifies the certificates of clients that present one. The certificate of
+// the originating secret is presented to the app container, and its CA verifies the app container.
+func LoadTLSConfigs(ctx context.Context) (terminating, originating *tls.Config, err error) {
+	cert, ca, err := loadTLSSecret(ctx, agentconfig.TerminatingTLSMountPoint)
+	if err != nil {
+		return nil, nil, err
+	}
+	if cert != nil {
+		terminating = &tls.Config{Certificates: []tls.Certificate{*cert}}
+		if ca != nil {
+			terminating.ClientCAs = ca
+			terminating.ClientAuth = tls.VerifyClientCertIfGiven
+		}
+	}
+	if cert, ca, err = loadTLSSecret(ctx, agentconfig.OriginatingTLSMountPoint); err != nil {
+		return nil, nil, err
+	}
+	if cert != nil {
+		originating = &tls.Config{Certificates: []tls.Certificate{*cert}, RootCAs: ca}
+	}
+	return terminating, originating, nil
+}
+
+// loadTLSSecret loads the certificate and the optional CA of the kubernetes.io/tls secret that is mounted
+// in the given directory. The certificate is nil when no secret is mounted there.
+func loadTLSSecret(ctx context.Context, dir string) (*tls.Certificate, *x509.CertPool, error) {
+	certPEM, err := dos.ReadFile(ctx, filepath.Join(dir, core.TLSCertKey))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	keyPEM, err := dos.ReadFile(ctx, filepath.Join(dir, core.TLSPrivateKeyKey))
+	if err != nil {
+		return nil, nil, err
+	}
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to load the TLS certificate in %s: %w", dir, err)
+	}
+	caPEM, err := dos.ReadFile(ctx, filepath.Join(dir, tlsCAKey))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return &cert, nil, nil
+		}
+		return nil, nil, err
+	}
+	ca := x509.NewCertPool()
+	if !ca.AppendCertsFromPEM(caPEM) {
+		return nil, nil, fmt.Errorf("unable to load the CA certificate in %s", dir)
+	}
+	return &cert, ca, nil
+}
@@ -19,6 +19,8 @@ func validateClient(client *rpc.ClientInfo) string {
 		return "product must not be empty"
 	case client.Version == "":
 		return "version must not be empty"
+	case client.EgressWorkload != "" && client.EgressNamespace == "":
+		return "egress namespace must not be empty when an egress workload is given"
 	}
 	sv, err := semver.Parse(strings.TrimPrefix(client.Version, "v"))
 	if err != nil {
@@ -76,6 +78,22 @@ func validateIntercept(spec *rpc.InterceptSpec) string {
 		return "namespace must not be empty"
 	case spec.Mechanism == "":
 		return "mechanism must not be empty"
+	case spec.Ttl.AsDuration() < 0:
+		return "ttl must not be negative"
+	case spec.IdleTimeout.AsDuration() < 0:
+		return "idle timeout must not be negative"
+	case spec.HealthCheckPath != "" && !strings.HasPrefix(spec.HealthCheckPath, "/"):
+		return "health check path must be absolute"
+	case spec.Mirror && (spec.Fallback || spec.HealthCheckPath != ""):
+		return "a mirroring intercept cannot fall back"
+	case spec.Replicas < 0:
+		return "replicas must not be negative"
+	case spec.Pod != "" && spec.Replicas > 0:
+		return "an intercept cannot be limited to both a pod and a number of replicas"
+	case spec.SampleRatio < 0 || spec.SampleRatio > 1:
+		return "sample ratio must be between zero and one"
+	case spec.Mirror && spec.SampleRatio > 0:
+		return "a mirroring intercept cannot sample"
 	}
 
 	return ""
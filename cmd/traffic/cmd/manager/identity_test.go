@@ -0,0 +1,116 @@
+package manager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/internal/config"
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+)
+
+func TestReviewClientIdentity(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset()
+	fakeClient.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		tr := action.(k8stesting.CreateAction).GetObject().(*authv1.TokenReview)
+		if tr.Spec.Token == "alice-token" {
+			tr.Status = authv1.TokenReviewStatus{
+				Authenticated: true,
+				User:          authv1.UserInfo{Username: "alice@example.com", Groups: []string{"developers"}},
+			}
+		}
+		return true, tr, nil
+	})
+	ctx := k8sapi.WithK8sInterface(dlog.NewTestContext(t, false), fakeClient)
+
+	tests := []struct {
+		name       string
+		token      string
+		wantUser   string
+		wantGroups []string
+	}{
+		{name: "authenticated", token: "alice-token", wantUser: "alice@example.com", wantGroups: []string{"developers"}},
+		{name: "unauthenticated", token: "forged-token"},
+		{name: "no token"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &rpc.ClientInfo{
+				Name:       "mallory@laptop",
+				KubeUser:   "admin",
+				KubeGroups: []string{"system:masters"},
+				KubeToken:  tt.token,
+			}
+			reviewClientIdentity(ctx, client)
+			assert.Equal(t, tt.wantUser, client.KubeUser)
+			assert.Equal(t, tt.wantGroups, client.KubeGroups)
+			assert.Empty(t, client.KubeToken)
+		})
+	}
+}
+
+type policyWatcher struct {
+	config.Watcher
+	policy *config.InterceptPolicy
+}
+
+func (w *policyWatcher) GetInterceptPolicy() (*config.InterceptPolicy, error) {
+	return w.policy, nil
+}
+
+func TestCheckEgressPolicy(t *testing.T) {
+	policy, err := config.ParseInterceptPolicy([]byte(`
+rules:
+  - name: developers
+    groups: ["developers"]
+    mechanisms: ["http", "egress"]
+  - name: testers
+    groups: ["testers"]
+    mechanisms: ["http"]
+`))
+	require.NoError(t, err)
+	m := &service{configWatcher: &policyWatcher{policy: policy}}
+
+	tests := []struct {
+		name     string
+		user     string
+		groups   []string
+		workload string
+		wantErr  string
+	}{
+		{name: "no egress workload", user: "bob@example.com", groups: []string{"testers"}},
+		{name: "allowed", user: "alice@example.com", groups: []string{"developers"}, workload: "orders"},
+		{name: "mechanism not allowed", user: "bob@example.com", groups: []string{"testers"}, workload: "orders", wantErr: "doesn't allow bob@example.com"},
+		{name: "unidentified", workload: "orders", wantErr: "doesn't allow an unidentified user"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &rpc.ClientInfo{
+				Name:            "laptop",
+				KubeUser:        tt.user,
+				KubeGroups:      tt.groups,
+				EgressWorkload:  tt.workload,
+				EgressNamespace: "default",
+			}
+			err := m.checkEgressPolicy(client)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.Equal(t, errcat.User, errcat.GetCategory(err))
+		})
+	}
+
+	m.configWatcher = &policyWatcher{}
+	assert.NoError(t, m.checkEgressPolicy(&rpc.ClientInfo{EgressWorkload: "orders", EgressNamespace: "default"}))
+}
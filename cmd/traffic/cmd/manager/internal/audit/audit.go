@@ -0,0 +1,265 @@
+// Package audit contains the audit log of the traffic-manager. The log records who did what with
+// sessions and intercepts, and when.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	core "k8s.io/api/core/v1"
+	events "k8s.io/api/events/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+)
+
+// Actions that are recorded in the audit log.
+const (
+	ActionArrive          = "arrive"
+	ActionDepart          = "depart"
+	ActionExpireSession   = "expire-session"
+	ActionCreateIntercept = "create-intercept"
+	ActionUpdateIntercept = "update-intercept"
+	ActionRemoveIntercept = "remove-intercept"
+	ActionReviewIntercept = "review-intercept"
+	ActionExpireIntercept = "expire-intercept"
+)
+
+// Outcomes of the recorded actions.
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+	OutcomeDenied  = "denied"
+)
+
+// eventReasons are the reasons used for Kubernetes Events on intercepted workloads, keyed by action.
+var eventReasons = map[string]string{ //nolint:gochecknoglobals // constant
+	ActionCreateIntercept: "InterceptCreated",
+	ActionUpdateIntercept: "InterceptUpdated",
+	ActionRemoveIntercept: "InterceptRemoved",
+	ActionReviewIntercept: "InterceptReviewed",
+	ActionExpireIntercept: "InterceptExpired",
+}
+
+// Entry is one line in the audit log.
+type Entry struct {
+	Time         time.Time `json:"time"`
+	Action       string    `json:"action"`
+	Outcome      string    `json:"outcome"`
+	Reason       string    `json:"reason,omitempty"`
+	SessionID    string    `json:"session_id,omitempty"`
+	User         string    `json:"user,omitempty"`
+	Host         string    `json:"host,omitempty"`
+	KubeUser     string    `json:"kube_user,omitempty"`
+	Intercept    string    `json:"intercept,omitempty"`
+	Workload     string    `json:"workload,omitempty"`
+	WorkloadKind string    `json:"workload_kind,omitempty"`
+	Namespace    string    `json:"namespace,omitempty"`
+	Ports        []string  `json:"ports,omitempty"`
+	Mechanism    string    `json:"mechanism,omitempty"`
+	Resource     string    `json:"resource,omitempty"`
+	Requester    string    `json:"requester,omitempty"`
+}
+
+// NewEntry returns an entry for the given action that was performed by the client with the given
+// session. The client may be nil.
+func NewEntry(action, sessionID string, client *rpc.ClientInfo) *Entry {
+	e := &Entry{Action: action, Outcome: OutcomeSuccess, SessionID: sessionID}
+	if client != nil {
+		// The client name is "user@hostname".
+		if i := strings.LastIndexByte(client.Name, '@'); i >= 0 {
+			e.User = client.Name[:i]
+			e.Host = client.Name[i+1:]
+		} else {
+			e.User = client.Name
+		}
+		e.KubeUser = client.KubeUser
+	}
+	return e
+}
+
+// WithIntercept adds the intercepted workload and its ports to the entry.
+func (e *Entry) WithIntercept(id string, spec *rpc.InterceptSpec) *Entry {
+	e.Intercept = id
+	if spec == nil {
+		return e
+	}
+	e.Workload = spec.Agent
+	e.WorkloadKind = spec.WorkloadKind
+	e.Namespace = spec.Namespace
+	e.Mechanism = spec.Mechanism
+	if spec.ServicePortName != "" {
+		e.Ports = append(e.Ports, spec.ServicePortName)
+	}
+	if spec.ServicePort > 0 {
+		e.Ports = append(e.Ports, strconv.Itoa(int(spec.ServicePort)))
+	}
+	return e
+}
+
+// WithResource adds the namespace/name key of the Intercept resource that the intercept is created
+// from, and the Kubernetes user that requested it, to the entry.
+func (e *Entry) WithResource(key, requester string) *Entry {
+	e.Resource = key
+	e.Requester = requester
+	return e
+}
+
+// WithError sets the outcome of the entry to failure and the reason to the error message, unless
+// the error is nil.
+func (e *Entry) WithError(err error) *Entry {
+	if err != nil {
+		e.Outcome = OutcomeFailure
+		e.Reason = err.Error()
+	}
+	return e
+}
+
+// eventQueueSize is the number of entries that can wait for their Kubernetes Event to be created.
+const eventQueueSize = 256
+
+// Log writes entries as JSON lines, and optionally creates Kubernetes Events for the entries that
+// concern intercepted workloads. A nil *Log discards all entries.
+type Log struct {
+	sync.Mutex
+	out    io.Writer
+	events chan *Entry
+}
+
+// NewLog returns a log that writes to the given output, which is either "stdout" or the path of a
+// file that entries are appended to. An empty output means that no JSON lines are written. When
+// kubernetesEvents is true, Kubernetes Events are created in the background until the given
+// context is cancelled. NewLog returns nil when neither is requested.
+func NewLog(ctx context.Context, output string, kubernetesEvents bool) (*Log, error) {
+	l := &Log{}
+	switch output {
+	case "":
+		if !kubernetesEvents {
+			return nil, nil
+		}
+	case "stdout":
+		l.out = os.Stdout
+	default:
+		if err := os.MkdirAll(filepath.Dir(output), 0o755); err != nil {
+			return nil, err
+		}
+		f, err := os.OpenFile(output, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, err
+		}
+		l.out = f
+	}
+	if kubernetesEvents {
+		l.events = make(chan *Entry, eventQueueSize)
+		go l.createEvents(ctx)
+	}
+	return l, nil
+}
+
+// NewWriterLog returns a log that writes JSON lines to the given writer.
+func NewWriterLog(out io.Writer) *Log {
+	return &Log{out: out}
+}
+
+// Record writes the given entry to the log.
+func (l *Log) Record(ctx context.Context, e *Entry) {
+	if l == nil {
+		return
+	}
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	if l.out != nil {
+		data, err := json.Marshal(e)
+		if err != nil {
+			dlog.Errorf(ctx, "unable to marshal audit entry: %v", err)
+			return
+		}
+		l.Lock()
+		_, err = l.out.Write(append(data, '\n'))
+		l.Unlock()
+		if err != nil {
+			dlog.Errorf(ctx, "unable to write audit entry: %v", err)
+		}
+	}
+	if l.events != nil {
+		if _, ok := eventReasons[e.Action]; ok && e.Workload != "" && e.WorkloadKind != "" {
+			// The event is created by createEvents, so that the caller doesn't wait for the API server.
+			ec := *e
+			select {
+			case l.events <- &ec:
+			default:
+				dlog.Errorf(ctx, "dropping %s event for %s.%s: too many events are waiting to be created", e.Action, e.Workload, e.Namespace)
+			}
+		}
+	}
+}
+
+// createEvents creates the Kubernetes Events of the entries that Record queues, until the given
+// context is cancelled.
+func (l *Log) createEvents(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e := <-l.events:
+			createEvent(ctx, eventReasons[e.Action], e)
+		}
+	}
+}
+
+// createEvent creates a Kubernetes Event on the workload of the given entry.
+func createEvent(ctx context.Context, reason string, e *Entry) {
+	tp := core.EventTypeNormal
+	if e.Outcome != OutcomeSuccess {
+		tp = core.EventTypeWarning
+	}
+	note := fmt.Sprintf("%s by %s@%s: %s", e.Action, e.User, e.Host, e.Outcome)
+	if e.Reason != "" {
+		note += ": " + e.Reason
+	}
+	host, _ := os.Hostname()
+	ev := &events.Event{
+		ObjectMeta: meta.ObjectMeta{
+			GenerateName: e.Workload + ".",
+			Namespace:    e.Namespace,
+		},
+		EventTime:           meta.NewMicroTime(e.Time),
+		ReportingController: "telepresence.io/traffic-manager",
+		ReportingInstance:   host,
+		Action:              e.Action,
+		Reason:              reason,
+		Regarding: core.ObjectReference{
+			APIVersion: workloadAPIVersion(e.WorkloadKind),
+			Kind:       e.WorkloadKind,
+			Name:       e.Workload,
+			Namespace:  e.Namespace,
+		},
+		Note: note,
+		Type: tp,
+	}
+	if _, err := k8sapi.GetK8sInterface(ctx).EventsV1().Events(e.Namespace).Create(ctx, ev, meta.CreateOptions{}); err != nil {
+		dlog.Errorf(ctx, "unable to create %s event for %s.%s: %v", reason, e.Workload, e.Namespace, err)
+	}
+}
+
+func workloadAPIVersion(kind string) string {
+	switch kind {
+	case "Pod":
+		return "v1"
+	case "Rollout":
+		return "argoproj.io/v1alpha1"
+	default:
+		return "apps/v1"
+	}
+}
@@ -0,0 +1,114 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	events "k8s.io/api/events/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+)
+
+func TestLog_Record(t *testing.T) {
+	buf := &bytes.Buffer{}
+	l := NewWriterLog(buf)
+	ctx := context.Background()
+
+	client := &rpc.ClientInfo{Name: "alice@laptop", KubeUser: "alice@example.com"}
+	spec := &rpc.InterceptSpec{
+		Name:            "orders",
+		Agent:           "orders",
+		WorkloadKind:    "Deployment",
+		Namespace:       "staging",
+		Mechanism:       "tcp",
+		ServicePortName: "http",
+		ServicePort:     8080,
+	}
+	l.Record(ctx, NewEntry(ActionArrive, "s1", client))
+	l.Record(ctx, NewEntry(ActionCreateIntercept, "s1", client).WithIntercept("s1:orders", spec).WithResource("staging/orders", "bob@example.com"))
+	l.Record(ctx, NewEntry(ActionRemoveIntercept, "s1", nil).WithIntercept("s1:orders", nil).WithError(errors.New("not found")))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+
+	var es [3]Entry
+	for i, line := range lines {
+		require.NoError(t, json.Unmarshal([]byte(line), &es[i]))
+		assert.False(t, es[i].Time.IsZero())
+	}
+
+	assert.Equal(t, ActionArrive, es[0].Action)
+	assert.Equal(t, OutcomeSuccess, es[0].Outcome)
+	assert.Equal(t, "alice", es[0].User)
+	assert.Equal(t, "laptop", es[0].Host)
+	assert.Equal(t, "alice@example.com", es[0].KubeUser)
+	assert.Empty(t, es[0].Intercept)
+
+	assert.Equal(t, "s1:orders", es[1].Intercept)
+	assert.Equal(t, "orders", es[1].Workload)
+	assert.Equal(t, "Deployment", es[1].WorkloadKind)
+	assert.Equal(t, "staging", es[1].Namespace)
+	assert.Equal(t, []string{"http", "8080"}, es[1].Ports)
+	assert.Equal(t, "staging/orders", es[1].Resource)
+	assert.Equal(t, "bob@example.com", es[1].Requester)
+
+	assert.Equal(t, OutcomeFailure, es[2].Outcome)
+	assert.Equal(t, "not found", es[2].Reason)
+	assert.Empty(t, es[2].User)
+}
+
+func TestNewLog(t *testing.T) {
+	l, err := NewLog(context.Background(), "", false)
+	require.NoError(t, err)
+	assert.Nil(t, l)
+
+	// A nil log discards entries.
+	l.Record(context.Background(), NewEntry(ActionDepart, "s1", nil))
+}
+
+func TestLog_events(t *testing.T) {
+	ctx, cancel := context.WithCancel(dlog.NewTestContext(t, false))
+	defer cancel()
+
+	// The API server doesn't respond until the test has recorded its entries.
+	release := make(chan struct{})
+	reasons := make(chan string, 10)
+	cs := fake.NewSimpleClientset()
+	cs.PrependReactor("create", "events", func(a k8stesting.Action) (bool, runtime.Object, error) {
+		<-release
+		ev := a.(k8stesting.CreateAction).GetObject().(*events.Event)
+		reasons <- ev.Reason
+		return true, ev, nil
+	})
+	ctx = k8sapi.WithK8sInterface(ctx, cs)
+	l, err := NewLog(ctx, "", true)
+	require.NoError(t, err)
+
+	client := &rpc.ClientInfo{Name: "alice@laptop"}
+	spec := &rpc.InterceptSpec{Name: "orders", Agent: "orders", WorkloadKind: "Deployment", Namespace: "staging"}
+	l.Record(ctx, NewEntry(ActionArrive, "s1", client))
+	l.Record(ctx, NewEntry(ActionCreateIntercept, "s1", client).WithIntercept("s1:orders", spec))
+	l.Record(ctx, NewEntry(ActionRemoveIntercept, "s1", client).WithIntercept("s1:orders", spec))
+	close(release)
+
+	for _, want := range []string{"InterceptCreated", "InterceptRemoved"} {
+		select {
+		case reason := <-reasons:
+			assert.Equal(t, want, reason)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no %s event was created", want)
+		}
+	}
+}
@@ -20,6 +20,7 @@ import (
 	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
 	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/license"
 	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/managerutil"
+	"github.com/telepresenceio/telepresence/v2/pkg/agentconfig"
 	"github.com/telepresenceio/telepresence/v2/pkg/install"
 	"github.com/telepresenceio/telepresence/v2/pkg/iputil"
 	"github.com/telepresenceio/telepresence/v2/pkg/subnet"
@@ -384,11 +385,8 @@ func (oi *info) GetTrafficAgentPods(ctx context.Context, agents string) ([]*core
 		if agents != "all" && !strings.Contains(pod.Name, agents) {
 			continue
 		}
-		for _, container := range pod.Spec.Containers {
-			if container.Name == install.AgentContainerName {
-				agentPods = append(agentPods, &pod)
-				break
-			}
+		if agentconfig.FindAgentContainer(&pod.Spec) != nil {
+			agentPods = append(agentPods, &pod)
 		}
 	}
 	return agentPods, nil
@@ -17,6 +17,7 @@ import (
 const (
 	clientConfigFileName         = "client.yaml"
 	trafficManagerConfigFileName = "traffic-manager.yaml"
+	interceptPolicyFileName      = "intercept-policy.yaml"
 	cfgConfigMapName             = "traffic-manager"
 )
 
@@ -26,6 +27,10 @@ type Watcher interface {
 	Run(ctx context.Context) error
 	GetClientConfigYaml() []byte
 	GetTrafficManagerConfigYaml() []byte
+
+	// GetInterceptPolicy returns the intercept policy, or nil if no policy has been configured. An
+	// error is returned when the configured policy is invalid.
+	GetInterceptPolicy() (*InterceptPolicy, error)
 }
 
 type config struct {
@@ -34,6 +39,8 @@ type config struct {
 
 	clientYAML         []byte
 	trafficManagerYAML []byte
+	interceptPolicy    *InterceptPolicy
+	interceptPolicyErr error
 }
 
 func NewWatcher(namespace string) Watcher {
@@ -103,6 +110,18 @@ func (c *config) refreshFile(ctx context.Context, data map[string]string) {
 		c.trafficManagerYAML = nil
 		dlog.Debugf(ctx, "Cleared traffic-manager config")
 	}
+
+	if yml, ok := data[interceptPolicyFileName]; ok {
+		c.interceptPolicy, c.interceptPolicyErr = ParseInterceptPolicy([]byte(yml))
+		if c.interceptPolicyErr != nil {
+			dlog.Errorf(ctx, "Invalid intercept policy, all intercepts will be denied: %v", c.interceptPolicyErr)
+		} else {
+			dlog.Debugf(ctx, "Refreshed intercept policy: %s", yml)
+		}
+	} else {
+		c.interceptPolicy, c.interceptPolicyErr = nil, nil
+		dlog.Debugf(ctx, "Cleared intercept policy")
+	}
 	c.Unlock()
 }
 
@@ -119,3 +138,9 @@ func (c *config) GetTrafficManagerConfigYaml() (ret []byte) {
 	c.RUnlock()
 	return
 }
+
+func (c *config) GetInterceptPolicy() (*InterceptPolicy, error) {
+	c.RLock()
+	defer c.RUnlock()
+	return c.interceptPolicy, c.interceptPolicyErr
+}
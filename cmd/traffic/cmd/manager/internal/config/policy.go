@@ -0,0 +1,184 @@
+package config
+
+import (
+	"fmt"
+	"path"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+)
+
+// Action is what an InterceptPolicy rule does with the requests that it matches.
+type Action string
+
+const (
+	ActionAllow Action = "allow"
+	ActionDeny  Action = "deny"
+)
+
+// EgressMechanism is the mechanism of the InterceptRequest that is checked when a client asks to route its
+// outbound connections through the traffic-agent of a workload.
+const EgressMechanism = "egress"
+
+// InterceptPolicy decides which clients may intercept which workloads. It is read from the
+// intercept-policy.yaml entry of the traffic-manager ConfigMap.
+//
+// The rules are evaluated in order, and the first rule that matches a request decides if the request
+// is allowed. A request that doesn't match any rule gets the DefaultAction, which is "deny" unless
+// specified.
+type InterceptPolicy struct {
+	DefaultAction Action        `json:"defaultAction,omitempty"`
+	Rules         []*PolicyRule `json:"rules,omitempty"`
+}
+
+// PolicyRule matches intercept requests. All fields except Name and Action are lists of patterns
+// using the syntax of path.Match. An empty list matches everything. Users and Groups are
+// combined, so a rule that lists both matches a client that matches either one of them.
+type PolicyRule struct {
+	Name       string   `json:"name,omitempty"`
+	Action     Action   `json:"action,omitempty"`
+	Users      []string `json:"users,omitempty"`
+	Groups     []string `json:"groups,omitempty"`
+	Namespaces []string `json:"namespaces,omitempty"`
+	Workloads  []string `json:"workloads,omitempty"`
+	Ports      []string `json:"ports,omitempty"`
+	Mechanisms []string `json:"mechanisms,omitempty"`
+}
+
+// InterceptRequest is what an InterceptPolicy checks.
+type InterceptRequest struct {
+	User      string
+	Groups    []string
+	Namespace string
+	Workload  string
+	Mechanism string
+
+	// Ports are the name and the number of the intercepted service port. They are empty when the
+	// port isn't known yet, in which case the request is allowed if it would be allowed for some port.
+	Ports []string
+}
+
+// ParseInterceptPolicy parses and validates the given YAML.
+func ParseInterceptPolicy(data []byte) (*InterceptPolicy, error) {
+	p := &InterceptPolicy{}
+	if err := yaml.UnmarshalStrict(data, p); err != nil {
+		return nil, err
+	}
+	if err := p.DefaultAction.validate(); err != nil {
+		return nil, fmt.Errorf("defaultAction: %w", err)
+	}
+	for i, r := range p.Rules {
+		if err := r.validate(); err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i+1, err)
+		}
+	}
+	return p, nil
+}
+
+func (a Action) validate() error {
+	switch a {
+	case "", ActionAllow, ActionDeny:
+		return nil
+	default:
+		return fmt.Errorf("invalid action %q, must be %q or %q", a, ActionAllow, ActionDeny)
+	}
+}
+
+func (r *PolicyRule) validate() error {
+	if err := r.Action.validate(); err != nil {
+		return err
+	}
+	for _, ps := range [][]string{r.Users, r.Groups, r.Namespaces, r.Workloads, r.Ports, r.Mechanisms} {
+		for _, p := range ps {
+			if _, err := path.Match(p, ""); err != nil {
+				return fmt.Errorf("invalid pattern %q: %w", p, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Check returns an errcat.User error when the policy denies the given request.
+func (p *InterceptPolicy) Check(r *InterceptRequest) error {
+	mechanism := r.Mechanism
+	if mechanism == "" {
+		mechanism = "tcp"
+	}
+	for i, rule := range p.Rules {
+		if !rule.matchesSubject(r) ||
+			!matchAny(rule.Namespaces, r.Namespace) ||
+			!matchAny(rule.Workloads, r.Workload) ||
+			!matchAny(rule.Mechanisms, mechanism) {
+			continue
+		}
+		action := rule.Action
+		if len(rule.Ports) > 0 {
+			if len(r.Ports) == 0 {
+				// The port isn't known yet. An allow rule might allow it, but a deny rule might not
+				// deny it, so the rules that follow must be considered.
+				if action == ActionDeny {
+					continue
+				}
+			} else if !matchAnyOf(rule.Ports, r.Ports) {
+				continue
+			}
+		}
+		if action == ActionDeny {
+			name := rule.Name
+			if name == "" {
+				name = fmt.Sprintf("rule %d", i+1)
+			}
+			return errcat.User.Newf("the intercept policy of the traffic-manager denies %s to intercept %s.%s (%s)",
+				subjectName(r.User), r.Workload, r.Namespace, name)
+		}
+		return nil
+	}
+	if p.DefaultAction == ActionAllow {
+		return nil
+	}
+	return errcat.User.Newf("the intercept policy of the traffic-manager doesn't allow %s to intercept %s.%s",
+		subjectName(r.User), r.Workload, r.Namespace)
+}
+
+func (r *PolicyRule) matchesSubject(ir *InterceptRequest) bool {
+	if len(r.Users) == 0 && len(r.Groups) == 0 {
+		return true
+	}
+	if ir.User != "" && len(r.Users) > 0 && matchAny(r.Users, ir.User) {
+		return true
+	}
+	return matchAnyOf(r.Groups, ir.Groups)
+}
+
+func subjectName(user string) string {
+	if user == "" {
+		return "an unidentified user"
+	}
+	return user
+}
+
+// matchAny returns true if the list of patterns is empty or if one of them matches the value.
+func matchAny(patterns []string, value string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, value); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// matchAnyOf returns true if one of the patterns matches one of the values.
+func matchAnyOf(patterns []string, values []string) bool {
+	for _, v := range values {
+		for _, p := range patterns {
+			if ok, _ := path.Match(p, v); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+)
+
+const testPolicy = `
+rules:
+  - name: no-production
+    action: deny
+    namespaces: ["prod-*"]
+  - name: no-admin-port
+    action: deny
+    ports: ["admin", "9000"]
+  - name: developers
+    groups: ["developers"]
+    mechanisms: ["http"]
+  - name: admins
+    users: ["admin@example.com"]
+`
+
+func TestInterceptPolicy_Check(t *testing.T) {
+	p, err := ParseInterceptPolicy([]byte(testPolicy))
+	require.NoError(t, err)
+
+	dev := func(ns, mechanism string, ports ...string) *InterceptRequest {
+		return &InterceptRequest{
+			User:      "alice@example.com",
+			Groups:    []string{"developers"},
+			Namespace: ns,
+			Workload:  "orders",
+			Mechanism: mechanism,
+			Ports:     ports,
+		}
+	}
+	admin := func(ns string, ports ...string) *InterceptRequest {
+		return &InterceptRequest{User: "admin@example.com", Namespace: ns, Workload: "orders", Ports: ports}
+	}
+
+	tests := []struct {
+		name string
+		req  *InterceptRequest
+		err  string
+	}{
+		{"developer using http", dev("staging", "http", "http", "8080"), ""},
+		{"developer using tcp", dev("staging", "tcp", "http", "8080"), "doesn't allow alice@example.com"},
+		{"developer in production", dev("prod-eu", "http"), "(no-production)"},
+		{"admin", admin("staging", "http", "8080"), ""},
+		{"admin port", admin("staging", "admin", "9000"), "(no-admin-port)"},
+		{"admin port not yet known", admin("staging"), ""},
+		{"admin in production", admin("prod-us"), "(no-production)"},
+		{"unidentified", &InterceptRequest{Namespace: "staging", Workload: "orders"}, "an unidentified user"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := p.Check(tt.req)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+				assert.Equal(t, errcat.User, errcat.GetCategory(err))
+			}
+		})
+	}
+
+	p.DefaultAction = ActionAllow
+	assert.NoError(t, p.Check(&InterceptRequest{Namespace: "staging", Workload: "orders"}))
+}
+
+func TestParseInterceptPolicy_invalid(t *testing.T) {
+	_, err := ParseInterceptPolicy([]byte("defaultAction: maybe"))
+	assert.ErrorContains(t, err, `invalid action "maybe"`)
+	_, err = ParseInterceptPolicy([]byte("rules:\n  - namespaces: [\"[\"]"))
+	assert.ErrorContains(t, err, "rule 1: invalid pattern")
+	_, err = ParseInterceptPolicy([]byte("rules:\n  - namespace: [default]"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,330 @@
+package interceptcrd
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	empty "google.golang.org/protobuf/types/known/emptypb"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/dlib/dtime"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/managerutil"
+	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/state"
+	"github.com/telepresenceio/telepresence/v2/pkg/agentconfig"
+	"github.com/telepresenceio/telepresence/v2/pkg/workload"
+)
+
+const (
+	// resyncPeriod is the interval between reconciliations of all Intercept resources. Intercepts that
+	// couldn't be created, e.g. because the traffic-agent wasn't ready, are retried this often.
+	resyncPeriod = 30 * time.Second
+
+	// crdCheckPeriod is the interval between checks for the Intercept CRD while it isn't installed.
+	crdCheckPeriod = time.Minute
+)
+
+// Manager is the part of the traffic-manager that the reconciler uses. Intercepts are prepared, created,
+// and removed using the same calls that the clients use, so that they're subject to the same intercept
+// policy and recorded in the audit log. The context of the calls that prepare and create an intercept
+// carries the requester of the resource, see GetRequester.
+type Manager interface {
+	PrepareIntercept(context.Context, *rpc.CreateInterceptRequest) (*rpc.PreparedIntercept, error)
+	CreateIntercept(context.Context, *rpc.CreateInterceptRequest) (*rpc.InterceptInfo, error)
+	RemoveIntercept(context.Context, *rpc.RemoveInterceptRequest2) (*empty.Empty, error)
+	State() *state.State
+}
+
+type reconciler struct {
+	mgr     Manager
+	di      dynamic.Interface
+	listers []cache.GenericLister
+	kick    chan struct{}
+}
+
+// Run reconciles the Intercept resources of the managed namespaces until the given context is cancelled.
+// Nothing is reconciled until the Intercept CRD is installed.
+func Run(ctx context.Context, mgr Manager) error {
+	di := workload.GetDynamicInterface(ctx)
+	if di == nil {
+		return nil
+	}
+	namespaces := managerutil.GetEnv(ctx).ManagedNamespaces
+	if len(namespaces) == 0 {
+		// Reconcile the resources of all namespaces
+		namespaces = []string{""}
+	}
+	if !waitForCRD(ctx, di, namespaces[0]) {
+		return nil
+	}
+
+	r := newReconciler(mgr, di)
+	for _, ns := range namespaces {
+		f := dynamicinformer.NewFilteredDynamicSharedInformerFactory(di, 0, ns, nil)
+		gi := f.ForResource(Resource)
+		if _, err := gi.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    func(any) { r.trigger() },
+			UpdateFunc: func(any, any) { r.trigger() },
+			DeleteFunc: func(any) { r.trigger() },
+		}); err != nil {
+			return err
+		}
+		r.listers = append(r.listers, gi.Lister())
+		f.Start(ctx.Done())
+		f.WaitForCacheSync(ctx.Done())
+	}
+	dlog.Info(ctx, "Reconciling Intercept resources")
+	return r.run(ctx)
+}
+
+func newReconciler(mgr Manager, di dynamic.Interface) *reconciler {
+	return &reconciler{
+		mgr:  mgr,
+		di:   di,
+		kick: make(chan struct{}, 1),
+	}
+}
+
+// waitForCRD waits until Intercept resources can be listed in the given namespace. It returns false if
+// the context is cancelled before that happens.
+func waitForCRD(ctx context.Context, di dynamic.Interface, namespace string) bool {
+	logged := false
+	for {
+		_, err := di.Resource(Resource).Namespace(namespace).List(ctx, meta.ListOptions{Limit: 1})
+		switch {
+		case err == nil:
+			return true
+		case ctx.Err() != nil:
+			return false
+		case k8serrors.IsNotFound(err) || k8serrors.IsForbidden(err):
+			if !logged {
+				dlog.Infof(ctx, "Intercept resources are not reconciled until the Intercept CRD is installed and accessible: %v", err)
+				logged = true
+			}
+		default:
+			dlog.Errorf(ctx, "unable to list Intercept resources: %v", err)
+		}
+		dtime.SleepWithContext(ctx, crdCheckPeriod)
+	}
+}
+
+// trigger makes the reconciler reconcile all resources as soon as possible.
+func (r *reconciler) trigger() {
+	select {
+	case r.kick <- struct{}{}:
+	default:
+	}
+}
+
+func (r *reconciler) run(ctx context.Context) error {
+	st := r.mgr.State()
+	go func() {
+		for range st.WatchClients(ctx, nil) {
+			r.trigger()
+		}
+	}()
+	go func() {
+		for range st.WatchIntercepts(ctx, func(_ string, ii *rpc.InterceptInfo) bool { return ii.Resource != nil }) {
+			r.trigger()
+		}
+	}()
+
+	ticker := time.NewTicker(resyncPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		case <-r.kick:
+		}
+		r.reconcile(ctx)
+	}
+}
+
+// reconcile creates intercepts for the Intercept resources, removes intercepts whose resources are gone,
+// and updates the status of each resource.
+func (r *reconciler) reconcile(ctx context.Context) {
+	var ics []*intercept
+	for _, l := range r.listers {
+		objs, err := l.List(labels.Everything())
+		if err != nil {
+			dlog.Errorf(ctx, "unable to list Intercept resources: %v", err)
+			return
+		}
+		for _, obj := range objs {
+			u, ok := obj.(*unstructured.Unstructured)
+			if !ok {
+				continue
+			}
+			ic, err := newIntercept(u.DeepCopy())
+			if err != nil {
+				dlog.Errorf(ctx, "Intercept %s/%s: %v", u.GetNamespace(), u.GetName(), err)
+				continue
+			}
+			ics = append(ics, ic)
+		}
+	}
+
+	st := r.mgr.State()
+	owned := make(map[string]*rpc.InterceptInfo)
+	for _, ii := range st.GetAllIntercepts() {
+		if res := ii.Resource; res != nil {
+			owned[res.Namespace+"/"+res.Name] = ii
+		}
+	}
+	clients := st.GetAllClients()
+	for _, ic := range ics {
+		ii := owned[ic.key()]
+		delete(owned, ic.key())
+		r.reconcileIntercept(ctx, ic, ii, clients)
+	}
+	for key, ii := range owned {
+		dlog.Infof(ctx, "Removing intercept %s because its Intercept resource %s is gone", ii.Spec.Name, key)
+		r.removeIntercept(ctx, ii)
+	}
+}
+
+// reconcileIntercept ensures that the given resource has an intercept if its client is connected, and
+// updates the status of the resource. The given intercept is the one that was created from the resource
+// earlier, if any.
+func (r *reconciler) reconcileIntercept(ctx context.Context, ic *intercept, ii *rpc.InterceptInfo, clients map[string]*rpc.ClientInfo) {
+	if ii != nil && (ii.Resource.Generation != ic.GetGeneration() || clients[ii.ClientSession.SessionId].GetName() != ic.spec.Client) {
+		dlog.Infof(ctx, "Recreating intercept %s because its Intercept resource %s has changed", ii.Spec.Name, ic.key())
+		r.removeIntercept(ctx, ii)
+		ii = nil
+	}
+	var f *failure
+	if ii == nil {
+		if sessionID := findClient(clients, ic.spec.Client); sessionID != "" {
+			ii, f = r.createIntercept(ctx, ic, sessionID)
+		}
+	}
+	r.updateStatus(ctx, ic, ic.newStatus(ii, f))
+}
+
+// findClient returns the ID of a session of the client with the given name, or an empty string if no such
+// client is connected. The same session is returned consistently when the client has several sessions.
+func findClient(clients map[string]*rpc.ClientInfo, name string) string {
+	var ids []string
+	for id, ci := range clients {
+		if ci.Name == name {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return ""
+	}
+	sort.Strings(ids)
+	return ids[0]
+}
+
+// createIntercept prepares and creates the intercept of the given resource on behalf of the client
+// with the given session ID, and of the requester of the resource.
+func (r *reconciler) createIntercept(ctx context.Context, ic *intercept, sessionID string) (*rpc.InterceptInfo, *failure) {
+	ctx = withRequester(ctx, ic.key(), ic.requester())
+	spec := ic.interceptSpec()
+	req := &rpc.CreateInterceptRequest{
+		Session:       &rpc.SessionInfo{SessionId: sessionID},
+		InterceptSpec: spec,
+	}
+	pi, err := r.mgr.PrepareIntercept(ctx, req)
+	if err == nil && pi.Error != "" {
+		err = errors.New(pi.Error)
+	}
+	if err != nil {
+		dlog.Errorf(ctx, "unable to prepare intercept %s: %v", spec.Name, err)
+		return nil, &failure{condition: ConditionAgentReady, reason: "PrepareFailed", message: err.Error()}
+	}
+
+	// Make the port identifier unambiguous, just like the clients do.
+	spec.ServiceUid = pi.ServiceUid
+	spec.ServiceName = pi.ServiceName
+	spec.ServicePortName = pi.ServicePortName
+	spec.ServicePort = pi.ServicePort
+	spec.Protocol = pi.Protocol
+	spec.WorkloadKind = pi.WorkloadKind
+	port := pi.ServicePortName
+	if port == "" {
+		port = strconv.Itoa(int(pi.ServicePort))
+	}
+	pid, err := agentconfig.NewPortIdentifier(pi.Protocol, port)
+	if err != nil {
+		return nil, &failure{condition: ConditionAgentReady, reason: "MisconfiguredWorkload", message: err.Error()}
+	}
+	spec.ServicePortIdentifier = pid.String()
+
+	ii, err := r.mgr.CreateIntercept(ctx, req)
+	if err != nil {
+		dlog.Errorf(ctx, "unable to create intercept %s: %v", spec.Name, err)
+		msg := status.Convert(err).Message()
+		switch status.Code(err) {
+		case codes.AlreadyExists:
+			return nil, &failure{condition: ConditionConflict, reason: "NameConflict", message: msg}
+		case codes.PermissionDenied:
+			return nil, &failure{condition: ConditionActive, reason: "Denied", message: msg}
+		default:
+			return nil, &failure{condition: ConditionActive, reason: "CreateFailed", message: msg}
+		}
+	}
+	dlog.Infof(ctx, "Created intercept %s from Intercept resource %s", ii.Id, ic.key())
+	ii = r.mgr.State().UpdateIntercept(ii.Id, func(ii *rpc.InterceptInfo) {
+		ii.Resource = &rpc.InterceptResource{
+			Namespace:  ic.GetNamespace(),
+			Name:       ic.GetName(),
+			Generation: ic.GetGeneration(),
+		}
+	})
+	if ii == nil {
+		return nil, &failure{condition: ConditionActive, reason: "CreateFailed", message: "the intercept was removed"}
+	}
+	return ii, nil
+}
+
+func (r *reconciler) removeIntercept(ctx context.Context, ii *rpc.InterceptInfo) {
+	_, err := r.mgr.RemoveIntercept(ctx, &rpc.RemoveInterceptRequest2{
+		Session: ii.ClientSession,
+		Name:    ii.Spec.Name,
+	})
+	if err != nil && status.Code(err) != codes.NotFound {
+		dlog.Errorf(ctx, "unable to remove intercept %s: %v", ii.Id, err)
+	}
+}
+
+// updateStatus updates the status of the given resource unless it's unchanged.
+func (r *reconciler) updateStatus(ctx context.Context, ic *intercept, st *Status) {
+	if equality.Semantic.DeepEqual(&ic.status, st) {
+		return
+	}
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(st)
+	if err != nil {
+		dlog.Errorf(ctx, "unable to convert the status of Intercept %s: %v", ic.key(), err)
+		return
+	}
+	u := ic.DeepCopy()
+	u.Object["status"] = m
+	if _, err = r.di.Resource(Resource).Namespace(ic.GetNamespace()).UpdateStatus(ctx, u, meta.UpdateOptions{}); err != nil {
+		if k8serrors.IsConflict(err) || k8serrors.IsNotFound(err) {
+			// The resource has changed or is gone. It will be reconciled again.
+			dlog.Debugf(ctx, "unable to update the status of Intercept %s: %v", ic.key(), err)
+		} else {
+			dlog.Errorf(ctx, "unable to update the status of Intercept %s: %v", ic.key(), err)
+		}
+		return
+	}
+	ic.status = *st
+}
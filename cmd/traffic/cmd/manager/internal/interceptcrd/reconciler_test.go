@@ -0,0 +1,255 @@
+package interceptcrd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	empty "google.golang.org/protobuf/types/known/emptypb"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/datawire/dlib/dlog"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+	testdata "github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/internal/test"
+	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/state"
+)
+
+// fakeManager prepares intercepts of all workloads except "missing", and adds and removes the
+// intercepts of its state. Intercepts requested by "mallory" are denied.
+type fakeManager struct {
+	state *state.State
+}
+
+func (m *fakeManager) PrepareIntercept(_ context.Context, req *rpc.CreateInterceptRequest) (*rpc.PreparedIntercept, error) {
+	if req.InterceptSpec.Agent == "missing" {
+		return &rpc.PreparedIntercept{Error: `deployments.apps "missing" not found`}, nil
+	}
+	return &rpc.PreparedIntercept{
+		Namespace:       req.InterceptSpec.Namespace,
+		ServiceUid:      "hello-uid",
+		ServiceName:     "hello",
+		ServicePortName: "http",
+		ServicePort:     80,
+		Protocol:        "TCP",
+		WorkloadKind:    "Deployment",
+	}, nil
+}
+
+func (m *fakeManager) CreateIntercept(ctx context.Context, req *rpc.CreateInterceptRequest) (*rpc.InterceptInfo, error) {
+	_, rq := GetRequester(ctx)
+	if rq == nil {
+		return nil, status.Error(codes.Internal, "the requester is unknown")
+	}
+	if rq.User == "mallory" {
+		return nil, status.Errorf(codes.PermissionDenied, "the intercept policy denies %s", rq.User)
+	}
+	sessionID := req.Session.SessionId
+	return m.state.AddIntercept(sessionID, "", "", m.state.GetClient(sessionID), req.InterceptSpec, time.Now())
+}
+
+func (m *fakeManager) RemoveIntercept(_ context.Context, req *rpc.RemoveInterceptRequest2) (*empty.Empty, error) {
+	if !m.state.RemoveIntercept(req.Session.SessionId + ":" + req.Name) {
+		return nil, status.Errorf(codes.NotFound, "Intercept named %q not found", req.Name)
+	}
+	return &empty.Empty{}, nil
+}
+
+func (m *fakeManager) State() *state.State {
+	return m.state
+}
+
+func newResource(name, workload, client string, generation int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": Resource.GroupVersion().String(),
+		"kind":       "Intercept",
+		"metadata": map[string]any{
+			"name":       name,
+			"namespace":  "default",
+			"generation": generation,
+		},
+		"spec": map[string]any{
+			"workload":   workload,
+			"client":     client,
+			"port":       "http",
+			"targetPort": int64(3000),
+		},
+	}}
+}
+
+type testEnv struct {
+	ctx     context.Context
+	state   *state.State
+	r       *reconciler
+	indexer cache.Indexer
+}
+
+func newTestEnv(t *testing.T, objs ...*unstructured.Unstructured) *testEnv {
+	ctx := dlog.NewTestContext(t, false)
+	st := state.NewState(ctx)
+	rObjs := make([]runtime.Object, len(objs))
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for i, obj := range objs {
+		rObjs[i] = obj
+		require.NoError(t, indexer.Add(obj))
+	}
+	di := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{Resource: "InterceptList"}, rObjs...)
+	r := newReconciler(&fakeManager{state: st}, di)
+	r.listers = []cache.GenericLister{cache.NewGenericLister(indexer, Resource.GroupResource())}
+	return &testEnv{ctx: ctx, state: st, r: r, indexer: indexer}
+}
+
+// status returns the status of the given resource as stored in the cluster.
+func (e *testEnv) status(t *testing.T, name string) *Status {
+	u, err := e.r.di.Resource(Resource).Namespace("default").Get(e.ctx, name, meta.GetOptions{})
+	require.NoError(t, err)
+	ic, err := newIntercept(u)
+	require.NoError(t, err)
+	return &ic.status
+}
+
+// update stores the given resource in the lister, retaining the status that the reconciler wrote.
+func (e *testEnv) update(t *testing.T, u *unstructured.Unstructured) {
+	cu, err := e.r.di.Resource(Resource).Namespace("default").Get(e.ctx, u.GetName(), meta.GetOptions{})
+	require.NoError(t, err)
+	u.Object["status"] = cu.Object["status"]
+	require.NoError(t, e.indexer.Update(u))
+}
+
+func condition(st *Status, cType string) *meta.Condition {
+	return apimeta.FindStatusCondition(st.Conditions, cType)
+}
+
+func TestReconcile(t *testing.T) {
+	testClients := testdata.GetTestClients(t)
+	testAgents := testdata.GetTestAgents(t)
+	alice := testClients["alice"]
+
+	res := newResource("hello", "hello", alice.Name, 1)
+	e := newTestEnv(t, res)
+
+	// The client isn't connected.
+	e.r.reconcile(e.ctx)
+	st := e.status(t, "hello")
+	assert.Equal(t, int64(1), st.ObservedGeneration)
+	assert.Equal(t, "", st.InterceptID)
+	assert.Equal(t, meta.ConditionFalse, condition(st, ConditionActive).Status)
+	assert.Equal(t, "ClientNotConnected", condition(st, ConditionActive).Reason)
+	assert.Equal(t, meta.ConditionUnknown, condition(st, ConditionAgentReady).Status)
+	assert.Equal(t, meta.ConditionFalse, condition(st, ConditionConflict).Status)
+	transitionTime := condition(st, ConditionActive).LastTransitionTime
+
+	// The client connects, and the intercept is created on its behalf.
+	e.state.AddAgent(testAgents["hello"], time.Now())
+	aliceID := e.state.AddClient(alice, time.Now())
+	e.update(t, res)
+	e.r.reconcile(e.ctx)
+	ii, ok := e.state.GetIntercept(aliceID + ":hello-default")
+	require.True(t, ok)
+	assert.Equal(t, &rpc.InterceptResource{Namespace: "default", Name: "hello", Generation: 1}, ii.Resource)
+	assert.Equal(t, "http/TCP", ii.Spec.ServicePortIdentifier)
+	assert.Equal(t, int32(3000), ii.Spec.TargetPort)
+	assert.Equal(t, "127.0.0.1", ii.Spec.TargetHost)
+	assert.Equal(t, "tcp", ii.Spec.Mechanism)
+	st = e.status(t, "hello")
+	assert.Equal(t, ii.Id, st.InterceptID)
+	assert.Equal(t, rpc.InterceptDispositionType_WAITING.String(), st.Disposition)
+	assert.Equal(t, meta.ConditionTrue, condition(st, ConditionAgentReady).Status)
+	assert.Equal(t, "Waiting", condition(st, ConditionActive).Reason)
+	assert.Equal(t, transitionTime, condition(st, ConditionActive).LastTransitionTime, "transition time changed although the status didn't")
+
+	// The agent activates the intercept.
+	e.state.UpdateIntercept(ii.Id, func(ii *rpc.InterceptInfo) {
+		ii.Disposition = rpc.InterceptDispositionType_ACTIVE
+	})
+	e.update(t, res)
+	e.r.reconcile(e.ctx)
+	st = e.status(t, "hello")
+	assert.Equal(t, meta.ConditionTrue, condition(st, ConditionActive).Status)
+
+	// A new generation of the resource replaces the intercept.
+	res2 := newResource("hello", "hello", alice.Name, 2)
+	e.update(t, res2)
+	e.r.reconcile(e.ctx)
+	ii, ok = e.state.GetIntercept(aliceID + ":hello-default")
+	require.True(t, ok)
+	assert.Equal(t, int64(2), ii.Resource.Generation)
+	assert.Equal(t, rpc.InterceptDispositionType_WAITING, ii.Disposition)
+	assert.Equal(t, int64(2), e.status(t, "hello").ObservedGeneration)
+
+	// The intercept is removed with its resource.
+	require.NoError(t, e.indexer.Delete(res2))
+	e.r.reconcile(e.ctx)
+	_, ok = e.state.GetIntercept(aliceID + ":hello-default")
+	assert.False(t, ok)
+}
+
+func TestReconcile_failures(t *testing.T) {
+	testClients := testdata.GetTestClients(t)
+	alice := testClients["alice"]
+	e := newTestEnv(t, newResource("missing", "missing", alice.Name, 1), newResource("taken", "hello", alice.Name, 1))
+	aliceID := e.state.AddClient(alice, time.Now())
+
+	// An intercept that the client created has the name of the intercept of the "taken" resource.
+	_, err := e.state.AddIntercept(aliceID, "", "", alice, &rpc.InterceptSpec{
+		Name: "taken-default", Client: alice.Name, Agent: "hello", Namespace: "default", Mechanism: "tcp",
+	}, time.Now())
+	require.NoError(t, err)
+
+	e.r.reconcile(e.ctx)
+	st := e.status(t, "missing")
+	assert.Equal(t, meta.ConditionFalse, condition(st, ConditionAgentReady).Status)
+	assert.Equal(t, "PrepareFailed", condition(st, ConditionAgentReady).Reason)
+
+	st = e.status(t, "taken")
+	assert.Equal(t, meta.ConditionTrue, condition(st, ConditionConflict).Status)
+	assert.Equal(t, "NameConflict", condition(st, ConditionConflict).Reason)
+
+	// The reconciler leaves the intercept that the client created alone.
+	ii, ok := e.state.GetIntercept(aliceID + ":taken-default")
+	require.True(t, ok)
+	assert.Nil(t, ii.Resource)
+}
+
+func TestReconcile_requester(t *testing.T) {
+	testClients := testdata.GetTestClients(t)
+	alice := testClients["alice"]
+	denied := newResource("denied", "hello", alice.Name, 1)
+	denied.SetAnnotations(map[string]string{RequesterAnnotation: `{"user":"mallory"}`})
+	unknown := newResource("unknown", "hello", alice.Name, 1)
+	e := newTestEnv(t, denied, unknown)
+	aliceID := e.state.AddClient(alice, time.Now())
+
+	e.r.reconcile(e.ctx)
+	st := e.status(t, "denied")
+	assert.Equal(t, meta.ConditionFalse, condition(st, ConditionActive).Status)
+	assert.Equal(t, "Denied", condition(st, ConditionActive).Reason)
+	_, ok := e.state.GetIntercept(aliceID + ":denied-default")
+	assert.False(t, ok)
+
+	// A resource without the annotation has an unidentified requester.
+	_, ok = e.state.GetIntercept(aliceID + ":unknown-default")
+	assert.True(t, ok)
+}
+
+func TestIntercept_requester(t *testing.T) {
+	res := newResource("hello", "hello", "alice", 1)
+	ic, err := newIntercept(res)
+	require.NoError(t, err)
+	assert.Equal(t, &Requester{}, ic.requester())
+
+	res.SetAnnotations(map[string]string{RequesterAnnotation: `{"user":"bob","groups":["dev"]}`})
+	assert.Equal(t, &Requester{User: "bob", Groups: []string{"dev"}}, ic.requester())
+
+	res.SetAnnotations(map[string]string{RequesterAnnotation: `bob`})
+	assert.Equal(t, &Requester{}, ic.requester())
+}
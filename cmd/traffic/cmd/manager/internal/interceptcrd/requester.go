@@ -0,0 +1,50 @@
+package interceptcrd
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// RequesterAnnotation is the annotation that the mutating webhook of the traffic-manager sets on an Intercept
+// resource when it's created, and when its spec is changed. The value is the JSON encoded Requester. The webhook
+// retains the value when only the metadata of the resource changes, so it can't be set by the users.
+const RequesterAnnotation = "telepresence.getambassador.io/requester"
+
+// Requester is the Kubernetes identity of the user that created an Intercept resource, or last changed its spec.
+type Requester struct {
+	User   string   `json:"user"`
+	Groups []string `json:"groups,omitempty"`
+}
+
+// requester returns the requester of the resource. An unidentified requester is returned when the annotation
+// is missing or invalid, which is the case when the webhook wasn't installed when the resource was created.
+func (ic *intercept) requester() *Requester {
+	r := &Requester{}
+	if v, ok := ic.GetAnnotations()[RequesterAnnotation]; ok {
+		if err := json.Unmarshal([]byte(v), r); err != nil {
+			return &Requester{}
+		}
+	}
+	return r
+}
+
+type requestKey struct{}
+
+type request struct {
+	resource  string
+	requester *Requester
+}
+
+func withRequester(ctx context.Context, resource string, r *Requester) context.Context {
+	return context.WithValue(ctx, requestKey{}, &request{resource: resource, requester: r})
+}
+
+// GetRequester returns the namespace/name key of the Intercept resource and its requester when the call that
+// the given context belongs to is made by the reconciler on behalf of that resource. Nil is returned for all
+// other calls.
+func GetRequester(ctx context.Context) (string, *Requester) {
+	if rq, ok := ctx.Value(requestKey{}).(*request); ok {
+		return rq.resource, rq.requester
+	}
+	return "", nil
+}
@@ -0,0 +1,225 @@
+// Package interceptcrd reconciles Intercept custom resources into intercepts. An Intercept resource declares
+// an intercept of a workload in its namespace on behalf of a named client session, so that intercepts can be
+// created using kubectl or GitOps tooling. The traffic-manager reports the state of the intercept back as
+// status conditions on the resource.
+package interceptcrd
+
+import (
+	"fmt"
+	"strings"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+)
+
+// Resource is the resource of an Intercept.
+var Resource = schema.GroupVersionResource{ //nolint:gochecknoglobals // constant
+	Group:    "telepresence.getambassador.io",
+	Version:  "v1alpha1",
+	Resource: "intercepts",
+}
+
+// The condition types of an Intercept's status.
+const (
+	// ConditionAgentReady is true when the traffic-agent of the intercepted workload is ready.
+	ConditionAgentReady = "AgentReady"
+
+	// ConditionActive is true when the intercepted traffic is sent to the client.
+	ConditionActive = "Active"
+
+	// ConditionConflict is true when the intercept conflicts with another intercept.
+	ConditionConflict = "Conflict"
+)
+
+const (
+	defaultTargetHost = "127.0.0.1"
+	defaultTargetPort = 8080
+	defaultMechanism  = "tcp"
+)
+
+// Spec is the spec of an Intercept.
+type Spec struct {
+	Workload      string             `json:"workload"`
+	WorkloadKind  string             `json:"workloadKind,omitempty"`
+	Service       string             `json:"service,omitempty"`
+	Port          intstr.IntOrString `json:"port,omitempty"`
+	Client        string             `json:"client"`
+	TargetHost    string             `json:"targetHost,omitempty"`
+	TargetPort    int32              `json:"targetPort,omitempty"`
+	Mechanism     string             `json:"mechanism,omitempty"`
+	MechanismArgs []string           `json:"mechanismArgs,omitempty"`
+	Mirror        bool               `json:"mirror,omitempty"`
+	Pod           string             `json:"pod,omitempty"`
+	Replicas      int32              `json:"replicas,omitempty"`
+}
+
+// Status is the status of an Intercept.
+type Status struct {
+	ObservedGeneration int64            `json:"observedGeneration,omitempty"`
+	InterceptID        string           `json:"interceptId,omitempty"`
+	Disposition        string           `json:"disposition,omitempty"`
+	Message            string           `json:"message,omitempty"`
+	Conditions         []meta.Condition `json:"conditions,omitempty"`
+}
+
+// intercept is an Intercept resource.
+type intercept struct {
+	*unstructured.Unstructured
+	spec   Spec
+	status Status
+}
+
+// newIntercept parses the spec and status of the given Intercept resource.
+func newIntercept(u *unstructured.Unstructured) (*intercept, error) {
+	ic := &intercept{Unstructured: u}
+	if m, ok, _ := unstructured.NestedMap(u.Object, "spec"); ok {
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &ic.spec); err != nil {
+			return nil, fmt.Errorf("invalid spec: %w", err)
+		}
+	}
+	if m, ok, _ := unstructured.NestedMap(u.Object, "status"); ok {
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &ic.status); err != nil {
+			return nil, fmt.Errorf("invalid status: %w", err)
+		}
+	}
+	return ic, nil
+}
+
+// key returns the namespace/name key of the resource.
+func (ic *intercept) key() string {
+	return ic.GetNamespace() + "/" + ic.GetName()
+}
+
+// owns returns true if the given intercept was created from this resource.
+func (ic *intercept) owns(ii *rpc.InterceptInfo) bool {
+	r := ii.GetResource()
+	return r != nil && r.Namespace == ic.GetNamespace() && r.Name == ic.GetName()
+}
+
+// interceptName returns the name of the intercept that the resource is reconciled into. The namespace
+// is included in the name, because the client may have intercepts in several namespaces.
+func (ic *intercept) interceptName() string {
+	return ic.GetName() + "-" + ic.GetNamespace()
+}
+
+// interceptSpec returns the spec of the intercept that the resource is reconciled into. The service
+// port is resolved by the traffic-manager when the intercept is prepared.
+func (ic *intercept) interceptSpec() *rpc.InterceptSpec {
+	s := &ic.spec
+	spec := &rpc.InterceptSpec{
+		Name:          ic.interceptName(),
+		Client:        s.Client,
+		Agent:         s.Workload,
+		WorkloadKind:  s.WorkloadKind,
+		Namespace:     ic.GetNamespace(),
+		ServiceName:   s.Service,
+		Mechanism:     s.Mechanism,
+		MechanismArgs: s.MechanismArgs,
+		Mirror:        s.Mirror,
+		TargetHost:    s.TargetHost,
+		TargetPort:    s.TargetPort,
+		Pod:           s.Pod,
+		Replicas:      s.Replicas,
+	}
+	if s.Port.Type == intstr.Int && s.Port.IntVal != 0 || s.Port.Type == intstr.String && s.Port.StrVal != "" {
+		spec.ServicePortIdentifier = s.Port.String()
+	}
+	if spec.Mechanism == "" {
+		spec.Mechanism = defaultMechanism
+	}
+	if spec.TargetHost == "" {
+		spec.TargetHost = defaultTargetHost
+	}
+	if spec.TargetPort == 0 {
+		spec.TargetPort = defaultTargetPort
+	}
+	return spec
+}
+
+// failure describes why the intercept of a resource couldn't be created. It's reported on the condition
+// of the given type.
+type failure struct {
+	condition string
+	reason    string
+	message   string
+}
+
+// newStatus returns the status of the resource given the intercept that it was reconciled into, or the
+// failure to create that intercept. The transition times of the current conditions are retained when
+// their status doesn't change.
+func (ic *intercept) newStatus(ii *rpc.InterceptInfo, f *failure) *Status {
+	st := &Status{
+		ObservedGeneration: ic.GetGeneration(),
+		Conditions:         append([]meta.Condition(nil), ic.status.Conditions...),
+	}
+	set := func(cType string, status meta.ConditionStatus, reason, message string) {
+		apimeta.SetStatusCondition(&st.Conditions, meta.Condition{
+			Type:               cType,
+			Status:             status,
+			ObservedGeneration: st.ObservedGeneration,
+			Reason:             reason,
+			Message:            message,
+		})
+	}
+
+	if ii == nil {
+		if f == nil {
+			f = &failure{condition: ConditionActive, reason: "ClientNotConnected", message: fmt.Sprintf("No client session named %q", ic.spec.Client)}
+		}
+		for _, cType := range []string{ConditionAgentReady, ConditionActive, ConditionConflict} {
+			switch {
+			case cType == f.condition:
+				status := meta.ConditionFalse
+				if cType == ConditionConflict {
+					status = meta.ConditionTrue
+				}
+				set(cType, status, f.reason, f.message)
+			case cType == ConditionAgentReady:
+				set(cType, meta.ConditionUnknown, "NotPrepared", "The intercept has not been prepared")
+			case cType == ConditionActive:
+				set(cType, meta.ConditionFalse, "NotCreated", "The intercept has not been created")
+			default:
+				set(cType, meta.ConditionFalse, "NoConflict", "")
+			}
+		}
+		return st
+	}
+
+	st.InterceptID = ii.Id
+	st.Disposition = ii.Disposition.String()
+	st.Message = ii.Message
+	if ii.Disposition == rpc.InterceptDispositionType_NO_AGENT {
+		set(ConditionAgentReady, meta.ConditionFalse, "NoAgent", ii.Message)
+	} else {
+		set(ConditionAgentReady, meta.ConditionTrue, "AgentReady", "")
+	}
+	if ii.Disposition == rpc.InterceptDispositionType_ACTIVE {
+		set(ConditionActive, meta.ConditionTrue, "Active", "")
+	} else {
+		set(ConditionActive, meta.ConditionFalse, dispositionReason(ii.Disposition), ii.Message)
+	}
+	if ii.Disposition == rpc.InterceptDispositionType_AGENT_ERROR && strings.HasPrefix(ii.Message, "Conflicts with") {
+		set(ConditionConflict, meta.ConditionTrue, "ConflictingIntercept", ii.Message)
+	} else {
+		set(ConditionConflict, meta.ConditionFalse, "NoConflict", "")
+	}
+	return st
+}
+
+// dispositionReason returns the given disposition in the CamelCase form used for condition reasons.
+func dispositionReason(d rpc.InterceptDispositionType) string {
+	var sb strings.Builder
+	for _, w := range strings.Split(strings.ToLower(d.String()), "_") {
+		if w != "" {
+			sb.WriteString(strings.ToUpper(w[:1]))
+			sb.WriteString(w[1:])
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,140 @@
+// Package leader elects the traffic-manager replica that holds the state of the sessions and intercepts, and runs
+// the agent-injector, using a Lease in the traffic-manager's namespace. The other replicas forward the calls of
+// their clients and agents to the leader, and one of them takes over when the leader goes away. The pod of the
+// leader is labeled with Label, so that the agent-injector and Prometheus services select only that pod.
+package leader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	"github.com/datawire/dlib/dcontext"
+	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+)
+
+// LeaseName is the name of the Lease that the replicas compete for.
+const LeaseName = "traffic-manager"
+
+// Label is set to "true" on the pod of the leader. The services of the agent-injector and of the Prometheus
+// metrics select pods with this label.
+const Label = "telepresence.io/traffic-manager-leader"
+
+// The timing of the election. Another replica takes over within leaseDuration after the leader stops
+// renewing the Lease.
+const (
+	leaseDuration = 15 * time.Second
+	renewDeadline = 10 * time.Second
+	retryPeriod   = 2 * time.Second
+)
+
+// ErrLostLeadership is returned by Run when the Lease was lost while the leader was serving.
+var ErrLostLeadership = errors.New("lost the traffic-manager leadership")
+
+// Run waits until the replica with the given identity is elected leader, and then calls the given function with a
+// context that is cancelled if the leadership is lost. The Lease is released when the function returns. The
+// identity is the name of the replica's pod, which has the Label while the function runs.
+//
+// The onNewLeader function is called with the identity of each newly elected leader other than this replica.
+//
+// Run returns the error of the function, ErrLostLeadership when the function ended because the leadership was lost,
+// or nil when the given context is cancelled before the replica becomes the leader.
+func Run(ctx context.Context, namespace, identity string, fn func(context.Context) error, onNewLeader func(context.Context, string)) error {
+	// A restarted container finds the label that it set before it restarted.
+	if err := setLabel(ctx, namespace, identity, false); err != nil {
+		dlog.Errorf(ctx, "unable to remove the %s label from pod %s: %v", Label, identity, err)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		stopped bool
+		err     error
+	)
+	le, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock: &resourcelock.LeaseLock{
+			LeaseMeta: meta.ObjectMeta{
+				Name:      LeaseName,
+				Namespace: namespace,
+			},
+			Client:     k8sapi.GetK8sInterface(ctx).CoordinationV1(),
+			LockConfig: resourcelock.ResourceLockConfig{Identity: identity},
+		},
+		LeaseDuration:   leaseDuration,
+		RenewDeadline:   renewDeadline,
+		RetryPeriod:     retryPeriod,
+		ReleaseOnCancel: true,
+		Name:            LeaseName,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(leCtx context.Context) {
+				// The elector starts this callback in a goroutine that may run after the election has ended.
+				mu.Lock()
+				if stopped {
+					mu.Unlock()
+					return
+				}
+				wg.Add(1)
+				mu.Unlock()
+				defer wg.Done()
+
+				dlog.Infof(ctx, "%s is the leader", identity)
+				if err = setLabel(leCtx, namespace, identity, true); err != nil {
+					err = fmt.Errorf("unable to add the %s label to pod %s: %w", Label, identity, err)
+					cancel()
+					return
+				}
+				err = fn(leCtx)
+				// The Lease is released when this function returns, so the label must be gone by then.
+				rmCtx, rmCancel := context.WithTimeout(dcontext.WithoutCancel(leCtx), renewDeadline)
+				if rmErr := setLabel(rmCtx, namespace, identity, false); rmErr != nil {
+					dlog.Errorf(ctx, "unable to remove the %s label from pod %s: %v", Label, identity, rmErr)
+				}
+				rmCancel()
+				if leCtx.Err() == nil {
+					// The function ended on its own. Release the Lease.
+					cancel()
+				} else if err == nil && ctx.Err() == nil {
+					err = ErrLostLeadership
+				}
+			},
+			OnStoppedLeading: func() {},
+			OnNewLeader: func(id string) {
+				if id != identity {
+					dlog.Infof(ctx, "%s is the leader", id)
+					onNewLeader(ctx, id)
+				}
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	le.Run(ctx)
+
+	mu.Lock()
+	stopped = true
+	mu.Unlock()
+	wg.Wait()
+	return err
+}
+
+// setLabel adds the Label to, or removes it from, the pod with the given name.
+func setLabel(ctx context.Context, namespace, podName string, leading bool) error {
+	value := "null"
+	if leading {
+		value = `"true"`
+	}
+	patch := fmt.Sprintf(`{"metadata":{"labels":{%q:%s}}}`, Label, value)
+	_, err := k8sapi.GetK8sInterface(ctx).CoreV1().Pods(namespace).Patch(ctx, podName, types.MergePatchType, []byte(patch), meta.PatchOptions{})
+	return err
+}
@@ -0,0 +1,121 @@
+package leader
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+)
+
+// replicaPods returns the pods of the replicas with the given identities.
+func replicaPods(identities ...string) []runtime.Object {
+	pods := make([]runtime.Object, len(identities))
+	for i, id := range identities {
+		pods[i] = &core.Pod{ObjectMeta: meta.ObjectMeta{Name: id, Namespace: "ambassador"}}
+	}
+	return pods
+}
+
+func TestRun(t *testing.T) {
+	ctx := dlog.NewTestContext(t, false)
+	ki := fake.NewSimpleClientset(replicaPods("a", "b")...)
+	ctx = k8sapi.WithK8sInterface(ctx, ki)
+	isLabeled := func(identity string) bool {
+		pod, err := ki.CoreV1().Pods("ambassador").Get(ctx, identity, meta.GetOptions{})
+		require.NoError(t, err)
+		return pod.Labels[Label] == "true"
+	}
+
+	leading := make(chan string, 2)
+	stop := map[string]chan struct{}{"a": make(chan struct{}), "b": make(chan struct{})}
+	errStopped := errors.New("stopped")
+	run := func(identity string) <-chan error {
+		done := make(chan error, 1)
+		go func() {
+			done <- Run(ctx, "ambassador", identity, func(ctx context.Context) error {
+				leading <- identity
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-stop[identity]:
+					return errStopped
+				}
+			}, func(context.Context, string) {})
+		}()
+		return done
+	}
+
+	aDone := run("a")
+	select {
+	case id := <-leading:
+		require.Equal(t, "a", id)
+	case <-time.After(5 * time.Second):
+		t.Fatal("a was not elected")
+	}
+	assert.True(t, isLabeled("a"))
+
+	// b stands by until a is done.
+	bDone := run("b")
+	select {
+	case <-leading:
+		t.Fatal("b was elected while a leads")
+	case <-time.After(time.Second):
+	}
+	assert.False(t, isLabeled("b"))
+	close(stop["a"])
+	assert.ErrorIs(t, <-aDone, errStopped)
+	assert.False(t, isLabeled("a"))
+
+	select {
+	case id := <-leading:
+		require.Equal(t, "b", id)
+	case <-time.After(leaseDuration):
+		t.Fatal("b did not take over")
+	}
+	assert.True(t, isLabeled("b"))
+	close(stop["b"])
+	assert.ErrorIs(t, <-bDone, errStopped)
+}
+
+func TestRun_cancelledStandby(t *testing.T) {
+	ctx := dlog.NewTestContext(t, false)
+	ctx = k8sapi.WithK8sInterface(ctx, fake.NewSimpleClientset(replicaPods("a", "b")...))
+
+	leading := make(chan struct{})
+	aCtx, aCancel := context.WithCancel(ctx)
+	defer aCancel()
+	go func() {
+		_ = Run(aCtx, "ambassador", "a", func(ctx context.Context) error {
+			close(leading)
+			<-ctx.Done()
+			return nil
+		}, func(context.Context, string) {})
+	}()
+	<-leading
+
+	bCtx, bCancel := context.WithTimeout(ctx, time.Second)
+	defer bCancel()
+	newLeader := make(chan string, 1)
+	assert.NoError(t, Run(bCtx, "ambassador", "b", func(ctx context.Context) error {
+		t.Error("b was elected while a leads")
+		return nil
+	}, func(_ context.Context, id string) {
+		newLeader <- id
+	}))
+	select {
+	case id := <-newLeader:
+		assert.Equal(t, "a", id)
+	case <-time.After(time.Second):
+		t.Error("b was not told that a is the leader")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/blang/semver"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"go.opentelemetry.io/otel"
@@ -28,6 +29,7 @@ import (
 	"github.com/telepresenceio/telepresence/v2/pkg/install"
 	"github.com/telepresenceio/telepresence/v2/pkg/maps"
 	"github.com/telepresenceio/telepresence/v2/pkg/tracing"
+	"github.com/telepresenceio/telepresence/v2/pkg/workload"
 )
 
 var podResource = meta.GroupVersionResource{Version: "v1", Group: "", Resource: "pods"} //nolint:gochecknoglobals // constant
@@ -36,8 +38,16 @@ type agentInjector struct {
 	sync.Mutex
 	agentConfigs Map
 	terminating  int64
+
+	// nativeSidecar is set once the injector has determined whether the Kubernetes server enables native sidecars.
+	nativeSidecar *bool
 }
 
+// nativeSidecarMinor is the minor version of the first Kubernetes 1.x release that enables native sidecars by default.
+// Kubernetes 1.28 introduced init containers with restartPolicy: Always, but only behind the SidecarContainers feature
+// gate, which is off by default. Clusters where it's enabled can use the Native sidecarMode.
+const nativeSidecarMinor = 29
+
 func getPod(req *admission.AdmissionRequest, isDelete bool) (*core.Pod, error) {
 	if req.Resource != podResource {
 		return nil, fmt.Errorf("expect resource to be %s, got %s", podResource, req.Resource)
@@ -174,7 +184,7 @@ func (a *agentInjector) inject(ctx context.Context, req *admission.AdmissionRequ
 
 	var patches patchOps
 	patches = addInitContainer(pod, config, patches)
-	patches = addAgentContainer(ctx, pod, config, patches)
+	patches = addAgentContainer(ctx, pod, config, a.useNativeSidecar(ctx), patches)
 	patches = addPullSecrets(pod, config, patches)
 	patches = addAgentVolumes(pod, config, patches)
 	patches = hidePorts(pod, config, patches)
@@ -206,6 +216,41 @@ func (a *agentInjector) upgradeLegacy(ctx context.Context) {
 	a.agentConfigs.UninstallV25(ctx)
 }
 
+// useNativeSidecar returns true if the traffic-agent is injected as a native sidecar, i.e. as an init container
+// with restartPolicy "Always". Unless the AGENT_SIDECAR_MODE forces a mode, native sidecars are used when the
+// Kubernetes server version enables them by default.
+func (a *agentInjector) useNativeSidecar(ctx context.Context) bool {
+	switch managerutil.GetEnv(ctx).AgentSidecarMode {
+	case agentconfig.SidecarModeNative:
+		return true
+	case agentconfig.SidecarModeContainer:
+		return false
+	}
+	a.Lock()
+	defer a.Unlock()
+	if a.nativeSidecar == nil {
+		info, err := k8sapi.GetK8sInterface(ctx).Discovery().ServerVersion()
+		if err != nil {
+			// Try again on the next injection.
+			dlog.Errorf(ctx, "unable to get the Kubernetes server version, the %s is injected as a regular container: %v", agentconfig.ContainerName, err)
+			return false
+		}
+		native := false
+		if v, err := semver.ParseTolerant(info.GitVersion); err == nil {
+			native = v.Major > 1 || v.Major == 1 && v.Minor >= nativeSidecarMinor
+		} else {
+			dlog.Errorf(ctx, "error converting version %s to semver: %v", info.GitVersion, err)
+		}
+		if native {
+			dlog.Infof(ctx, "Kubernetes %s supports native sidecars, the %s is injected as an init container", info.GitVersion, agentconfig.ContainerName)
+		} else {
+			dlog.Infof(ctx, "Kubernetes %s doesn't enable native sidecars, the %s is injected as a regular container", info.GitVersion, agentconfig.ContainerName)
+		}
+		a.nativeSidecar = &native
+	}
+	return *a.nativeSidecar
+}
+
 func needInitContainer(config *agentconfig.Sidecar) bool {
 	for _, cc := range config.Containers {
 		for _, ic := range cc.Intercepts {
@@ -357,11 +402,13 @@ func containerEqual(a, b *core.Container) bool {
 		cmpopts.IgnoreFields(core.Container{}, "ImagePullPolicy", "Resources", "TerminationMessagePath", "TerminationMessagePolicy"))
 }
 
-// addAgentContainer creates a patch operation to add the traffic-agent container.
+// addAgentContainer creates a patch operation to add the traffic-agent container. The container is added as
+// a native sidecar when native is true. A container that is already present is updated where it is.
 func addAgentContainer(
 	ctx context.Context,
 	pod *core.Pod,
 	config *agentconfig.Sidecar,
+	native bool,
 	patches patchOps,
 ) patchOps {
 	acn := agentconfig.AgentContainer(ctx, pod, config)
@@ -385,11 +432,41 @@ func addAgentContainer(
 			})
 		}
 	}
+	for i := range pod.Spec.InitContainers {
+		pcn := &pod.Spec.InitContainers[i]
+		if pcn.Name == agentconfig.ContainerName {
+			if containerEqual(pcn, acn) {
+				dlog.Infof(ctx, "Pod %s already has init container %s and it isn't modified", refPodName, agentconfig.ContainerName)
+				return patches
+			}
+			dlog.Debugf(ctx, "Pod %s already has init container %s but it is modified", refPodName, agentconfig.ContainerName)
+			return append(patches, patchOperation{
+				Op:    "replace",
+				Path:  "/spec/initContainers/" + strconv.Itoa(i),
+				Value: agentconfig.NewNativeSidecar(acn),
+			})
+		}
+	}
 
+	if !native {
+		return append(patches, patchOperation{
+			Op:    "add",
+			Path:  "/spec/containers/-",
+			Value: acn,
+		})
+	}
+	if len(pod.Spec.InitContainers) == 0 && !needInitContainer(config) {
+		// The pod has no init containers, and addInitContainer didn't add one.
+		return append(patches, patchOperation{
+			Op:    "replace",
+			Path:  "/spec/initContainers",
+			Value: []*agentconfig.NativeSidecar{agentconfig.NewNativeSidecar(acn)},
+		})
+	}
 	return append(patches, patchOperation{
 		Op:    "add",
-		Path:  "/spec/containers/-",
-		Value: acn,
+		Path:  "/spec/initContainers/-",
+		Value: agentconfig.NewNativeSidecar(acn),
 	})
 }
 
@@ -607,5 +684,16 @@ func (a *agentInjector) findConfigMapValue(ctx context.Context, pod *core.Pod, w
 			return a.findConfigMapValue(ctx, pod, wl)
 		}
 	}
+	if wl == nil && workload.IsBarePod(pod) {
+		// A bare pod is its own workload.
+		ag := agentconfig.Sidecar{}
+		ok, err := a.agentConfigs.GetInto(pod.Name, pod.GetNamespace(), &ag)
+		if err != nil {
+			return nil, err
+		}
+		if ok && ag.WorkloadKind == workload.PodKind {
+			return &ag, nil
+		}
+	}
 	return nil, nil
 }
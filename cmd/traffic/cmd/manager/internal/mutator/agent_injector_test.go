@@ -18,6 +18,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
 	"k8s.io/client-go/kubernetes/fake"
 	"sigs.k8s.io/yaml"
 
@@ -1740,3 +1742,95 @@ func generateForPod(t *testing.T, ctx context.Context, pod *core.Pod, gc *agentm
 	}
 	return agentmap.Generate(ctx, wl, gc)
 }
+
+func TestAddAgentContainer_nativeSidecar(t *testing.T) {
+	ctx := dlog.NewTestContext(t, false)
+	config := &agentconfig.Sidecar{
+		AgentName:  "hello",
+		Namespace:  "default",
+		AgentImage: "docker.io/datawire/tel2:2.13.0",
+		Containers: []*agentconfig.Container{{
+			Name: "app",
+			Intercepts: []*agentconfig.Intercept{{
+				ContainerPortName: "http",
+				ServicePortName:   "http",
+				Protocol:          core.ProtocolTCP,
+				ContainerPort:     8080,
+				AgentPort:         9900,
+			}},
+		}},
+	}
+	appPod := func(initContainers ...core.Container) *core.Pod {
+		return &core.Pod{
+			ObjectMeta: meta.ObjectMeta{Name: "hello-xyz", Namespace: "default"},
+			Spec: core.PodSpec{
+				InitContainers: initContainers,
+				Containers: []core.Container{{
+					Name:  "app",
+					Ports: []core.ContainerPort{{Name: "http", ContainerPort: 8080}},
+				}},
+			},
+		}
+	}
+
+	t.Run("container", func(t *testing.T) {
+		patches := addAgentContainer(ctx, appPod(), config, false, nil)
+		require.Len(t, patches, 1)
+		assert.Equal(t, "/spec/containers/-", patches[0].Path)
+		assert.IsType(t, &core.Container{}, patches[0].Value)
+	})
+
+	t.Run("native without init containers", func(t *testing.T) {
+		patches := addAgentContainer(ctx, appPod(), config, true, nil)
+		require.Len(t, patches, 1)
+		assert.Equal(t, "replace", patches[0].Op)
+		assert.Equal(t, "/spec/initContainers", patches[0].Path)
+		js, err := json.Marshal(patches[0].Value)
+		require.NoError(t, err)
+		var cns []map[string]any
+		require.NoError(t, json.Unmarshal(js, &cns))
+		require.Len(t, cns, 1)
+		assert.Equal(t, agentconfig.ContainerName, cns[0]["name"])
+		assert.Equal(t, "Always", cns[0]["restartPolicy"])
+	})
+
+	t.Run("native with init containers", func(t *testing.T) {
+		patches := addAgentContainer(ctx, appPod(core.Container{Name: "setup"}), config, true, nil)
+		require.Len(t, patches, 1)
+		assert.Equal(t, "add", patches[0].Op)
+		assert.Equal(t, "/spec/initContainers/-", patches[0].Path)
+		assert.Equal(t, "Always", patches[0].Value.(*agentconfig.NativeSidecar).RestartPolicy)
+	})
+
+	t.Run("native already injected", func(t *testing.T) {
+		pod := appPod()
+		pod.Spec.InitContainers = []core.Container{*agentconfig.AgentContainer(ctx, pod, config)}
+		assert.Empty(t, addAgentContainer(ctx, pod, config, true, nil))
+	})
+}
+
+func TestUseNativeSidecar(t *testing.T) {
+	tests := []struct {
+		mode    agentconfig.SidecarMode
+		version string
+		native  bool
+	}{
+		{agentconfig.SidecarModeAuto, "v1.28.3", false},
+		{agentconfig.SidecarModeAuto, "v1.29.0-eks-c12679a", true},
+		{agentconfig.SidecarModeAuto, "v1.30.1+k3s1", true},
+		{agentconfig.SidecarModeNative, "v1.28.3", true},
+		{agentconfig.SidecarModeContainer, "v1.30.1", false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.mode.String()+"-"+tt.version, func(t *testing.T) {
+			clientset := fake.NewSimpleClientset()
+			clientset.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &version.Info{GitVersion: tt.version}
+			ctx := dlog.NewTestContext(t, false)
+			ctx = k8sapi.WithK8sInterface(ctx, clientset)
+			ctx = managerutil.WithEnv(ctx, &managerutil.Env{AgentSidecarMode: tt.mode})
+			a := &agentInjector{}
+			assert.Equal(t, tt.native, a.useNativeSidecar(ctx))
+		})
+	}
+}
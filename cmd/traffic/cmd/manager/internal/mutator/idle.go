@@ -0,0 +1,126 @@
+package mutator
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/telepresenceio/telepresence/v2/pkg/agentconfig"
+	"github.com/telepresenceio/telepresence/v2/pkg/tracing"
+)
+
+// idleCheckInterval is the interval between the checks for idle agents.
+const idleCheckInterval = 30 * time.Second
+
+// AgentUsage tells the configWatcher whether agents are intercepted, and is told when the agents that
+// aren't will be removed. It is implemented by the traffic-manager's state.
+type AgentUsage interface {
+	// HasIntercepts returns true if the agent of the given workload has intercepts.
+	HasIntercepts(agentName, namespace string) bool
+
+	// SetAgentIdleRemoval records when the agent of the given workload will be removed. A zero time
+	// means that it won't be removed.
+	SetAgentIdleRemoval(agentName, namespace string, at time.Time)
+}
+
+type agentUsageKey struct{}
+
+// WithAgentUsage returns a context that makes the given AgentUsage available to the configWatcher,
+// which then removes agents that haven't been intercepted for the AGENT_IDLE_TIMEOUT.
+func WithAgentUsage(ctx context.Context, au AgentUsage) context.Context {
+	return context.WithValue(ctx, agentUsageKey{}, au)
+}
+
+func getAgentUsage(ctx context.Context) AgentUsage {
+	if au, ok := ctx.Value(agentUsageKey{}).(AgentUsage); ok {
+		return au
+	}
+	return nil
+}
+
+// runIdleRemover removes idle agents every idleCheckInterval until the context is cancelled.
+func (c *configWatcher) runIdleRemover(ctx context.Context, au AgentUsage, timeout time.Duration) {
+	ticker := time.NewTicker(idleCheckInterval)
+	defer ticker.Stop()
+	for {
+		c.removeIdleAgents(ctx, au, timeout, time.Now())
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// removeIdleAgents deletes the configs of the agents that haven't been intercepted during the given timeout,
+// which in turn triggers a rollout of their workloads without the agent. An agent that was never seen
+// intercepted is considered intercepted when the configWatcher first saw it. Agents that were manually
+// added, or that belong to workloads annotated with the agentconfig.KeepAnnotation, are never removed.
+func (c *configWatcher) removeIdleAgents(ctx context.Context, au AgentUsage, timeout time.Duration, now time.Time) {
+	var acs []*agentconfig.Sidecar
+	c.RLock()
+	for _, nm := range c.data {
+		for _, v := range nm {
+			ac := &agentconfig.Sidecar{}
+			if err := decode(v, ac); err != nil {
+				dlog.Errorf(ctx, "failed to decode ConfigMap entry %q into an agent config", v)
+				continue
+			}
+			if !(ac.Manual || ac.Create) {
+				acs = append(acs, ac)
+			}
+		}
+	}
+	c.RUnlock()
+
+	if c.lastIntercepted == nil {
+		c.lastIntercepted = make(map[string]time.Time)
+	}
+	seen := make(map[string]struct{}, len(acs))
+	for _, ac := range acs {
+		key := ac.AgentName + "." + ac.Namespace
+		seen[key] = struct{}{}
+		if au.HasIntercepts(ac.AgentName, ac.Namespace) {
+			c.lastIntercepted[key] = now
+			au.SetAgentIdleRemoval(ac.AgentName, ac.Namespace, time.Time{})
+			continue
+		}
+		last, ok := c.lastIntercepted[key]
+		if !ok {
+			last = now
+			c.lastIntercepted[key] = last
+		}
+
+		wl, err := tracing.GetWorkload(ctx, ac.WorkloadName, ac.Namespace, ac.WorkloadKind)
+		if err != nil {
+			if !errors.IsNotFound(err) {
+				dlog.Error(ctx, err)
+			}
+			continue
+		}
+		if wl.GetAnnotations()[agentconfig.KeepAnnotation] == "true" || wl.GetPodTemplate().GetAnnotations()[agentconfig.KeepAnnotation] == "true" {
+			au.SetAgentIdleRemoval(ac.AgentName, ac.Namespace, time.Time{})
+			continue
+		}
+		removal := last.Add(timeout)
+		if now.Before(removal) {
+			au.SetAgentIdleRemoval(ac.AgentName, ac.Namespace, removal)
+			continue
+		}
+
+		dlog.Infof(ctx, "Removing the traffic-agent of %s %s.%s because it hasn't been intercepted for %s",
+			ac.WorkloadKind, ac.WorkloadName, ac.Namespace, timeout)
+		au.SetAgentIdleRemoval(ac.AgentName, ac.Namespace, time.Time{})
+		delete(c.lastIntercepted, key)
+		if err = c.Delete(ctx, ac.AgentName, ac.Namespace); err != nil {
+			dlog.Errorf(ctx, "unable to remove the idle traffic-agent of %s.%s: %v", ac.AgentName, ac.Namespace, err)
+		}
+	}
+	for key := range c.lastIntercepted {
+		if _, ok := seen[key]; !ok {
+			delete(c.lastIntercepted, key)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package mutator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/yaml"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+	"github.com/telepresenceio/telepresence/v2/pkg/agentconfig"
+)
+
+type fakeAgentUsage struct {
+	intercepted map[string]bool
+	removals    map[string]time.Time
+}
+
+func (u *fakeAgentUsage) HasIntercepts(agentName, _ string) bool {
+	return u.intercepted[agentName]
+}
+
+func (u *fakeAgentUsage) SetAgentIdleRemoval(agentName, _ string, at time.Time) {
+	if at.IsZero() {
+		delete(u.removals, agentName)
+	} else {
+		u.removals[agentName] = at
+	}
+}
+
+func TestRemoveIdleAgents(t *testing.T) {
+	deployment := func(name string, annotations map[string]string) *apps.Deployment {
+		return &apps.Deployment{
+			TypeMeta:   meta.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+			ObjectMeta: meta.ObjectMeta{Name: name, Namespace: "default", Annotations: annotations},
+		}
+	}
+	cmData := make(map[string]string)
+	for _, name := range []string{"hello", "busy", "kept"} {
+		js, err := yaml.Marshal(&agentconfig.Sidecar{AgentName: name, Namespace: "default", WorkloadName: name, WorkloadKind: "Deployment"})
+		require.NoError(t, err)
+		cmData[name] = string(js)
+	}
+	clientset := fake.NewSimpleClientset(
+		deployment("hello", nil),
+		deployment("busy", nil),
+		deployment("kept", map[string]string{agentconfig.KeepAnnotation: "true"}),
+		&core.ConfigMap{
+			ObjectMeta: meta.ObjectMeta{Name: agentconfig.ConfigMap, Namespace: "default"},
+			Data:       cmData,
+		},
+	)
+	ctx := dlog.NewTestContext(t, false)
+	ctx = k8sapi.WithK8sInterface(ctx, clientset)
+
+	cw := NewWatcher(agentconfig.ConfigMap, "default")
+	cw.data["default"] = cmData
+	au := &fakeAgentUsage{intercepted: map[string]bool{"busy": true}, removals: make(map[string]time.Time)}
+	start := time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC)
+	timeout := time.Hour
+
+	cw.removeIdleAgents(ctx, au, timeout, start)
+	assert.Equal(t, map[string]time.Time{"hello": start.Add(timeout)}, au.removals)
+
+	// busy is idle from now on.
+	now := start.Add(30 * time.Minute)
+	cw.removeIdleAgents(ctx, au, timeout, now)
+	au.intercepted["busy"] = false
+	cw.removeIdleAgents(ctx, au, timeout, now.Add(time.Minute))
+	assert.Equal(t, map[string]time.Time{"hello": start.Add(timeout), "busy": now.Add(timeout)}, au.removals)
+
+	// hello has been idle for the timeout.
+	cw.removeIdleAgents(ctx, au, timeout, start.Add(timeout))
+	assert.Equal(t, map[string]time.Time{"busy": now.Add(timeout)}, au.removals)
+	cm, err := clientset.CoreV1().ConfigMaps("default").Get(ctx, agentconfig.ConfigMap, meta.GetOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, cm.Data, "hello")
+	assert.Contains(t, cm.Data, "busy")
+	assert.Contains(t, cm.Data, "kept")
+}
@@ -0,0 +1,73 @@
+package mutator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	admission "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/internal/interceptcrd"
+)
+
+// stampRequester sets the interceptcrd.RequesterAnnotation of an Intercept resource to the identity of the user
+// that creates the resource, or that changes its spec. Any other change retains the annotation of the old
+// resource, so that the users can't set it.
+func stampRequester(ctx context.Context, req *admission.AdmissionRequest) (patchOps, error) {
+	if req.Resource.Group != interceptcrd.Resource.Group || req.Resource.Resource != interceptcrd.Resource.Resource || req.SubResource != "" {
+		return nil, nil
+	}
+	obj, err := decodeUnstructured(req.Object.Raw)
+	if err != nil {
+		return nil, err
+	}
+
+	value, stamp := "", true
+	if req.Operation == admission.Update {
+		old, err := decodeUnstructured(req.OldObject.Raw)
+		if err != nil {
+			return nil, err
+		}
+		if equality.Semantic.DeepEqual(old.Object["spec"], obj.Object["spec"]) {
+			value, stamp = old.GetAnnotations()[interceptcrd.RequesterAnnotation], false
+		}
+	}
+	if stamp {
+		data, err := json.Marshal(&interceptcrd.Requester{User: req.UserInfo.Username, Groups: req.UserInfo.Groups})
+		if err != nil {
+			return nil, err
+		}
+		value = string(data)
+		dlog.Debugf(ctx, "Intercept %s.%s is requested by %s", req.Name, req.Namespace, req.UserInfo.Username)
+	}
+
+	anns := obj.GetAnnotations()
+	current, ok := anns[interceptcrd.RequesterAnnotation]
+	switch {
+	case value == "" && !ok, ok && current == value:
+		return nil, nil
+	case value == "":
+		return patchOps{{Op: "remove", Path: annotationPath(interceptcrd.RequesterAnnotation)}}, nil
+	case anns == nil:
+		return patchOps{{Op: "add", Path: "/metadata/annotations", Value: map[string]string{interceptcrd.RequesterAnnotation: value}}}, nil
+	default:
+		return patchOps{{Op: "add", Path: annotationPath(interceptcrd.RequesterAnnotation), Value: value}}, nil
+	}
+}
+
+func decodeUnstructured(raw []byte) (*unstructured.Unstructured, error) {
+	u := &unstructured.Unstructured{}
+	if err := u.UnmarshalJSON(raw); err != nil {
+		return nil, fmt.Errorf("could not deserialize Intercept resource: %w", err)
+	}
+	return u, nil
+}
+
+// annotationPath returns the JSON pointer of the annotation with the given key.
+func annotationPath(key string) string {
+	return "/metadata/annotations/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+}
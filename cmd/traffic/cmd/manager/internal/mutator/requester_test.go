@@ -0,0 +1,112 @@
+package mutator
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admission "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/internal/interceptcrd"
+)
+
+func TestStampRequester(t *testing.T) {
+	const (
+		alice = `{"user":"alice","groups":["dev"]}`
+		bob   = `{"user":"bob","groups":["dev"]}`
+	)
+	path := "/metadata/annotations/telepresence.getambassador.io~1requester"
+	resource := func(workload, requester string) runtime.RawExtension {
+		md := map[string]any{"name": "echo", "namespace": "default"}
+		if requester != "" {
+			md["annotations"] = map[string]string{interceptcrd.RequesterAnnotation: requester}
+		}
+		data, err := json.Marshal(map[string]any{
+			"apiVersion": interceptcrd.Resource.GroupVersion().String(),
+			"kind":       "Intercept",
+			"metadata":   md,
+			"spec":       map[string]any{"workload": workload, "client": "alice@laptop"},
+		})
+		require.NoError(t, err)
+		return runtime.RawExtension{Raw: data}
+	}
+
+	tests := []struct {
+		name        string
+		op          admission.Operation
+		subResource string
+		old         runtime.RawExtension
+		obj         runtime.RawExtension
+		want        patchOps
+	}{
+		{
+			name: "create",
+			op:   admission.Create,
+			obj:  resource("echo", ""),
+			want: patchOps{{Op: "add", Path: "/metadata/annotations", Value: map[string]string{interceptcrd.RequesterAnnotation: bob}}},
+		},
+		{
+			name: "create with forged requester",
+			op:   admission.Create,
+			obj:  resource("echo", alice),
+			want: patchOps{{Op: "add", Path: path, Value: bob}},
+		},
+		{
+			name: "update of the spec",
+			op:   admission.Update,
+			old:  resource("echo", alice),
+			obj:  resource("hello", alice),
+			want: patchOps{{Op: "add", Path: path, Value: bob}},
+		},
+		{
+			name: "update of the metadata",
+			op:   admission.Update,
+			old:  resource("echo", alice),
+			obj:  resource("echo", alice),
+		},
+		{
+			name: "update of the requester",
+			op:   admission.Update,
+			old:  resource("echo", alice),
+			obj:  resource("echo", bob),
+			want: patchOps{{Op: "add", Path: path, Value: alice}},
+		},
+		{
+			name: "update that adds a requester",
+			op:   admission.Update,
+			old:  resource("echo", ""),
+			obj:  resource("echo", bob),
+			want: patchOps{{Op: "remove", Path: path}},
+		},
+		{
+			name:        "update of the status",
+			op:          admission.Update,
+			subResource: "status",
+			old:         resource("echo", ""),
+			obj:         resource("echo", ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := dlog.NewTestContext(t, false)
+			req := &admission.AdmissionRequest{
+				Resource:    meta.GroupVersionResource(interceptcrd.Resource),
+				SubResource: tt.subResource,
+				Name:        "echo",
+				Namespace:   "default",
+				Operation:   tt.op,
+				UserInfo:    authenticationv1.UserInfo{Username: "bob", Groups: []string{"dev"}},
+				Object:      tt.obj,
+				OldObject:   tt.old,
+			}
+			ops, err := stampRequester(ctx, req)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, ops)
+		})
+	}
+}
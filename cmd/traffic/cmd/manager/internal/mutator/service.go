@@ -88,6 +88,19 @@ func ServeMutator(ctx context.Context) error {
 			dlog.Errorf(ctx, "could not write response: %v", err)
 		}
 	})
+	mux.HandleFunc("/intercept-requester", func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		dlog.Debug(ctx, "Received intercept requester webhook request...")
+		bytes, statusCode, err := serveMutatingFunc(ctx, r, stampRequester)
+		if err != nil {
+			dlog.Errorf(ctx, "error handling intercept requester webhook request: %v", err)
+			w.WriteHeader(statusCode)
+			bytes = []byte(err.Error())
+		}
+		if _, err = w.Write(bytes); err != nil {
+			dlog.Errorf(ctx, "could not write response: %v", err)
+		}
+	})
 	mux.HandleFunc("/uninstall", func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 		dlog.Debug(ctx, "Received uninstall request...")
@@ -29,6 +29,7 @@ import (
 	"github.com/telepresenceio/telepresence/v2/pkg/agentmap"
 	"github.com/telepresenceio/telepresence/v2/pkg/install"
 	"github.com/telepresenceio/telepresence/v2/pkg/tracing"
+	"github.com/telepresenceio/telepresence/v2/pkg/workload"
 )
 
 type Map interface {
@@ -78,6 +79,14 @@ func triggerRollout(ctx context.Context, wl k8sapi.Workload) {
 		triggerRolloutReplicaSet(ctx, wl, rs, span)
 		return
 	}
+	if _, ok := workload.PodImpl(wl); ok {
+		triggerRolloutPod(ctx, wl, span)
+		return
+	}
+	if _, ok := workload.RolloutImpl(wl); ok {
+		triggerRolloutArgo(ctx, wl, span)
+		return
+	}
 	restartAnnotation := fmt.Sprintf(
 		`{"spec": {"template": {"metadata": {"annotations": {"%srestartedAt": "%s"}}}}}`,
 		install.DomainPrefix,
@@ -147,6 +156,27 @@ func triggerRolloutReplicaSet(ctx context.Context, wl k8sapi.Workload, rs *v1.Re
 	}
 }
 
+func triggerRolloutArgo(ctx context.Context, wl k8sapi.Workload, span trace.Span) {
+	// An Argo Rollout will restart its pods without creating a new revision when spec.restartAt is set.
+	restartAt := fmt.Sprintf(`{"spec": {"restartAt": "%s"}}`, time.Now().UTC().Format(time.RFC3339))
+	span.AddEvent("tel2.do-rollout")
+	if err := wl.Patch(ctx, types.MergePatchType, []byte(restartAt)); err != nil {
+		err = fmt.Errorf("unable to patch %s %s.%s: %v", wl.GetKind(), wl.GetName(), wl.GetNamespace(), err)
+		dlog.Error(ctx, err)
+		span.SetStatus(codes.Error, err.Error())
+		return
+	}
+	dlog.Infof(ctx, "Successfully restarted %s.%s", wl.GetName(), wl.GetNamespace())
+}
+
+func triggerRolloutPod(ctx context.Context, wl k8sapi.Workload, span trace.Span) {
+	// A bare pod has no controller that can recreate it, and the workload is lost if it is deleted and the
+	// recreation fails. The user must recreate it, so that the webhook can inject or remove the traffic-agent.
+	span.AddEvent("tel2.noop-rollout")
+	dlog.Warnf(ctx, "Pod %s.%s isn't controlled by a workload, so it is not restarted. Recreate it to apply the change of its traffic-agent",
+		wl.GetName(), wl.GetNamespace())
+}
+
 // RegenerateAgentMaps load the telepresence-agents config map, regenerates all entries in it,
 // and then, if any of the entries changed, it updates the map.
 func RegenerateAgentMaps(ctx context.Context, agentImage string) error {
@@ -230,6 +260,10 @@ type configWatcher struct {
 	data       map[string]map[string]string
 	modCh      chan entry
 	delCh      chan entry
+
+	// lastIntercepted is the time when each agent, keyed by "name.namespace", was last seen intercepted.
+	// It's only accessed by the idle remover.
+	lastIntercepted map[string]time.Time
 }
 
 type entry struct {
@@ -245,6 +279,11 @@ func (c *configWatcher) Run(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if timeout := managerutil.GetEnv(ctx).AgentIdleTimeout; timeout > 0 {
+		if au := getAgentUsage(ctx); au != nil {
+			go c.runIdleRemover(ctx, au, timeout)
+		}
+	}
 	for {
 		select {
 		case <-ctx.Done():
@@ -620,6 +659,11 @@ func (c *configWatcher) affectedConfigs(ctx context.Context, svc *core.Service,
 		if stss, err := k8sapi.StatefulSets(ctx, ns, selector); err == nil {
 			wls = append(wls, stss...)
 		}
+		for _, kind := range []string{workload.DaemonSetKind, workload.RolloutKind, workload.PodKind} {
+			if kwls, err := workload.Workloads(ctx, kind, ns, selector); err == nil {
+				wls = append(wls, kwls...)
+			}
+		}
 	}
 	return c.configsAffectedByWorkloads(ctx, nsData, wls)
 }
@@ -0,0 +1,197 @@
+// Package persist saves the client sessions and intercepts of the traffic-manager in a Secret and restores
+// them when the traffic-manager starts, so that a restart or an upgrade of the traffic-manager doesn't drop
+// the intercepts in the cluster. Clients that return with their previous session find their intercepts as
+// they left them.
+package persist
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	core "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/dlib/dtime"
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/state"
+	"github.com/telepresenceio/telepresence/v2/pkg/version"
+)
+
+const (
+	// SecretName is the name of the Secret in the traffic-manager's namespace that holds the state.
+	SecretName = "traffic-manager-state"
+
+	// dataKey is the key of the state in the Secret's data.
+	dataKey = "state.json"
+
+	// saveInterval is the minimum time between two saves of the state.
+	saveInterval = time.Second
+)
+
+// document is the JSON form of a state.Snapshot. The messages are in their protojson form.
+type document struct {
+	Clients    map[string]json.RawMessage `json:"clients,omitempty"`
+	Intercepts map[string]json.RawMessage `json:"intercepts,omitempty"`
+}
+
+func encode(snap *state.Snapshot) ([]byte, error) {
+	doc := document{
+		Clients:    make(map[string]json.RawMessage, len(snap.Clients)),
+		Intercepts: make(map[string]json.RawMessage, len(snap.Intercepts)),
+	}
+	for id, ci := range snap.Clients {
+		data, err := protojson.Marshal(ci)
+		if err != nil {
+			return nil, fmt.Errorf("client session %s: %w", id, err)
+		}
+		doc.Clients[id] = data
+	}
+	for id, ii := range snap.Intercepts {
+		data, err := protojson.Marshal(ii)
+		if err != nil {
+			return nil, fmt.Errorf("intercept %s: %w", id, err)
+		}
+		doc.Intercepts[id] = data
+	}
+	// The map keys are sorted, so the same snapshot always yields the same JSON.
+	return json.Marshal(&doc)
+}
+
+func decode(data []byte) (*state.Snapshot, error) {
+	var doc document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	snap := &state.Snapshot{
+		Clients:    make(map[string]*rpc.ClientInfo, len(doc.Clients)),
+		Intercepts: make(map[string]*rpc.InterceptInfo, len(doc.Intercepts)),
+	}
+	uo := protojson.UnmarshalOptions{DiscardUnknown: true}
+	for id, data := range doc.Clients {
+		ci := new(rpc.ClientInfo)
+		if err := uo.Unmarshal(data, ci); err != nil {
+			return nil, fmt.Errorf("client session %s: %w", id, err)
+		}
+		snap.Clients[id] = ci
+	}
+	for id, data := range doc.Intercepts {
+		ii := new(rpc.InterceptInfo)
+		if err := uo.Unmarshal(data, ii); err != nil {
+			return nil, fmt.Errorf("intercept %s: %w", id, err)
+		}
+		snap.Intercepts[id] = ii
+	}
+	return snap, nil
+}
+
+// Load restores the client sessions and intercepts that were saved in the Secret of the given namespace.
+// Nothing is restored when there is no such Secret.
+func Load(ctx context.Context, st *state.State, namespace string) error {
+	sec, err := k8sapi.GetK8sInterface(ctx).CoreV1().Secrets(namespace).Get(ctx, SecretName, meta.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get Secret %s.%s: %w", SecretName, namespace, err)
+	}
+	data, ok := sec.Data[dataKey]
+	if !ok {
+		return nil
+	}
+	snap, err := decode(data)
+	if err != nil {
+		return fmt.Errorf("failed to decode Secret %s.%s: %w", SecretName, namespace, err)
+	}
+	st.Restore(ctx, snap, time.Now())
+	return nil
+}
+
+// Run saves the client sessions and intercepts of the given state in the Secret of the given namespace each
+// time they change, until the context is cancelled.
+func Run(ctx context.Context, st *state.State, namespace string) error {
+	changed := make(chan struct{}, 1)
+	notify := func() {
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	}
+	go func() {
+		for range st.WatchClients(ctx, nil) {
+			notify()
+		}
+	}()
+	go func() {
+		for range st.WatchIntercepts(ctx, nil) {
+			notify()
+		}
+	}()
+
+	var saved []byte
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changed:
+		}
+		data, err := encode(st.Snapshot())
+		if err != nil {
+			dlog.Errorf(ctx, "failed to encode the traffic-manager state: %v", err)
+			continue
+		}
+		if bytes.Equal(data, saved) {
+			continue
+		}
+		if err = save(ctx, namespace, data); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			// Try again after the save interval, even if nothing changes.
+			dlog.Errorf(ctx, "failed to save the traffic-manager state: %v", err)
+			notify()
+		} else {
+			saved = data
+		}
+		dtime.SleepWithContext(ctx, saveInterval)
+	}
+}
+
+// save stores the given data in the Secret of the given namespace, creating the Secret if it doesn't exist.
+func save(ctx context.Context, namespace string, data []byte) error {
+	api := k8sapi.GetK8sInterface(ctx).CoreV1().Secrets(namespace)
+	sec, err := api.Get(ctx, SecretName, meta.GetOptions{})
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return err
+		}
+		_, err = api.Create(ctx, &core.Secret{
+			TypeMeta: meta.TypeMeta{
+				Kind:       "Secret",
+				APIVersion: "v1",
+			},
+			ObjectMeta: meta.ObjectMeta{
+				Name:      SecretName,
+				Namespace: namespace,
+				Labels: map[string]string{
+					"app.kubernetes.io/name":       SecretName,
+					"app.kubernetes.io/created-by": "traffic-manager",
+					"app.kubernetes.io/version":    strings.TrimPrefix(version.Version, "v"),
+				},
+			},
+			Type: core.SecretTypeOpaque,
+			Data: map[string][]byte{dataKey: data},
+		}, meta.CreateOptions{})
+		return err
+	}
+	sec.Data = map[string][]byte{dataKey: data}
+	_, err = api.Update(ctx, sec, meta.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,57 @@
+package persist
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+	testdata "github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/internal/test"
+	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/state"
+)
+
+func TestRunAndLoad(t *testing.T) {
+	ctx := dlog.NewTestContext(t, false)
+	ctx = k8sapi.WithK8sInterface(ctx, fake.NewSimpleClientset())
+	testClients := testdata.GetTestClients(t)
+	testAgents := testdata.GetTestAgents(t)
+
+	// Nothing is restored when nothing was saved.
+	require.NoError(t, Load(ctx, state.NewState(ctx), "ambassador"))
+
+	st := state.NewState(ctx)
+	st.AddAgent(testAgents["hello"], time.Now())
+	aliceID := st.AddClient(testClients["alice"], time.Now())
+	ii, err := st.AddIntercept(aliceID, "", "", testClients["alice"], &rpc.InterceptSpec{
+		Name: "hello", Client: "alice", Agent: "hello", Namespace: "default", Mechanism: "tcp", TargetPort: 8080,
+	}, time.Now())
+	require.NoError(t, err)
+
+	runCtx, cancel := context.WithCancel(ctx)
+	done := make(chan error)
+	go func() {
+		done <- Run(runCtx, st, "ambassador")
+	}()
+	secrets := k8sapi.GetK8sInterface(ctx).CoreV1().Secrets("ambassador")
+	require.Eventually(t, func() bool {
+		_, err := secrets.Get(ctx, SecretName, meta.GetOptions{})
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	cancel()
+	require.NoError(t, <-done)
+
+	restored := state.NewState(ctx)
+	require.NoError(t, Load(ctx, restored, "ambassador"))
+	assert.Equal(t, testClients["alice"].Name, restored.GetClient(aliceID).GetName())
+	rii, ok := restored.GetIntercept(ii.Id)
+	require.True(t, ok)
+	assert.Equal(t, int32(8080), rii.Spec.TargetPort)
+	assert.Equal(t, rpc.InterceptDispositionType_WAITING, rii.Disposition)
+}
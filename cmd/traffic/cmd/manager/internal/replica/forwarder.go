@@ -0,0 +1,222 @@
+// Package replica lets the traffic-manager replicas that aren't the leader serve clients and agents. A replica
+// forwards each gRPC call that it receives to the leader, which holds the state of all sessions and intercepts.
+// The two ends of a tunnel therefore meet at the leader, also when they are connected to different replicas.
+package replica
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/datawire/dlib/dhttp"
+	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+)
+
+// Forwarder forwards gRPC calls to the leader, without decoding the messages.
+type Forwarder struct {
+	namespace string
+	port      uint16
+	dialOpts  []grpc.DialOption
+
+	mu     sync.Mutex
+	leader string
+	conn   *grpc.ClientConn
+}
+
+// NewForwarder returns a Forwarder that forwards calls to the given port of the leader's pod in the given namespace.
+func NewForwarder(namespace string, port uint16, dialOpts ...grpc.DialOption) *Forwarder {
+	return &Forwarder{namespace: namespace, port: port, dialOpts: dialOpts}
+}
+
+// SetLeader makes the Forwarder forward calls to the pod with the given name. Calls that are in progress to the
+// previous leader end, and their callers reconnect.
+func (f *Forwarder) SetLeader(ctx context.Context, podName string) {
+	f.mu.Lock()
+	f.leader = podName
+	f.mu.Unlock()
+
+	pod, err := k8sapi.GetK8sInterface(ctx).CoreV1().Pods(f.namespace).Get(ctx, podName, meta.GetOptions{})
+	if err != nil {
+		dlog.Errorf(ctx, "unable to get the pod of the traffic-manager leader %s: %v", podName, err)
+		return
+	}
+	if pod.Status.PodIP == "" {
+		dlog.Errorf(ctx, "the pod of the traffic-manager leader %s has no IP", podName)
+		return
+	}
+	addr := net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(int(f.port)))
+	opts := append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, f.dialOpts...)
+	conn, err := grpc.DialContext(ctx, addr, opts...)
+	if err != nil {
+		dlog.Errorf(ctx, "unable to dial the traffic-manager leader %s at %s: %v", podName, addr, err)
+		return
+	}
+
+	f.mu.Lock()
+	if f.leader != podName {
+		// Another leader was elected while this one was looked up.
+		f.mu.Unlock()
+		_ = conn.Close()
+		return
+	}
+	old := f.conn
+	f.conn = conn
+	f.mu.Unlock()
+	if old != nil {
+		_ = old.Close()
+	}
+	dlog.Infof(ctx, "forwarding calls to the traffic-manager leader %s at %s", podName, addr)
+}
+
+// Close closes the connection to the leader.
+func (f *Forwarder) Close() {
+	f.mu.Lock()
+	conn := f.conn
+	f.leader, f.conn = "", nil
+	f.mu.Unlock()
+	if conn != nil {
+		_ = conn.Close()
+	}
+}
+
+// Serve forwards the gRPC calls that arrive on the given listener until the context is cancelled. The calls that
+// are in progress end when it's cancelled.
+func (f *Forwarder) Serve(ctx context.Context, ln net.Listener, opts ...grpc.ServerOption) error {
+	opts = append(opts, grpc.UnknownServiceHandler(f.forward), grpc.ForceServerCodec(frameCodec{}))
+	srv := grpc.NewServer(opts...)
+	go func() {
+		<-ctx.Done()
+		srv.Stop()
+	}()
+	sc := &dhttp.ServerConfig{Handler: srv}
+	return sc.Serve(ctx, ln)
+}
+
+func (f *Forwarder) forward(_ any, ss grpc.ServerStream) error {
+	method, ok := grpc.MethodFromServerStream(ss)
+	if !ok {
+		return status.Error(codes.Internal, "unable to determine the method of the call")
+	}
+	f.mu.Lock()
+	conn := f.conn
+	f.mu.Unlock()
+	if conn == nil {
+		return status.Error(codes.Unavailable, "the traffic-manager leader is unknown")
+	}
+
+	ctx, cancel := context.WithCancel(ss.Context())
+	defer cancel()
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = metadata.NewOutgoingContext(ctx, md.Copy())
+	}
+	cs, err := conn.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true, ClientStreams: true}, method, grpc.ForceCodec(frameCodec{}))
+	if err != nil {
+		return err
+	}
+
+	upDone := make(chan error, 1)
+	go func() { upDone <- forwardUp(ss, cs) }()
+	downDone := make(chan error, 1)
+	go func() { downDone <- forwardDown(cs, ss) }()
+	for {
+		select {
+		case err := <-upDone:
+			if err != nil {
+				// The caller is gone. Cancelling the call ends forwardDown.
+				cancel()
+				<-downDone
+				return err
+			}
+			// The caller has sent all its messages. Wait for the leader to respond.
+			upDone = nil
+		case err := <-downDone:
+			ss.SetTrailer(cs.Trailer())
+			return err
+		}
+	}
+}
+
+// forwardUp forwards the messages from the caller to the leader.
+func forwardUp(ss grpc.ServerStream, cs grpc.ClientStream) error {
+	for {
+		fr := &frame{}
+		if err := ss.RecvMsg(fr); err != nil {
+			if errors.Is(err, io.EOF) {
+				return cs.CloseSend()
+			}
+			return err
+		}
+		if err := cs.SendMsg(fr); err != nil {
+			if errors.Is(err, io.EOF) {
+				// The leader ended the call. forwardDown receives its status.
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// forwardDown forwards the header and the messages from the leader to the caller, and returns the status of the
+// call, which is nil when it succeeded.
+func forwardDown(cs grpc.ClientStream, ss grpc.ServerStream) error {
+	// A call that fails before the leader sends the header has no header. Its status is returned by RecvMsg.
+	if md, err := cs.Header(); err == nil {
+		if err = ss.SendHeader(md); err != nil {
+			return err
+		}
+	}
+	for {
+		fr := &frame{}
+		if err := cs.RecvMsg(fr); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if err := ss.SendMsg(fr); err != nil {
+			return err
+		}
+	}
+}
+
+// frame is an encoded message.
+type frame struct {
+	payload []byte
+}
+
+// frameCodec passes encoded messages through as frames. Its name is the name of the proto codec, so that the
+// leader decodes the messages.
+type frameCodec struct{}
+
+func (frameCodec) Marshal(v any) ([]byte, error) {
+	fr, ok := v.(*frame)
+	if !ok {
+		return nil, fmt.Errorf("unable to marshal %T", v)
+	}
+	return fr.payload, nil
+}
+
+func (frameCodec) Unmarshal(data []byte, v any) error {
+	fr, ok := v.(*frame)
+	if !ok {
+		return fmt.Errorf("unable to unmarshal into %T", v)
+	}
+	fr.payload = append([]byte(nil), data...)
+	return nil
+}
+
+func (frameCodec) Name() string {
+	return "proto"
+}
@@ -0,0 +1,129 @@
+package replica
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+)
+
+// leaderServer echoes the messages of tunnels, and knows no intercepts.
+type leaderServer struct {
+	rpc.UnimplementedManagerServer
+}
+
+func (leaderServer) Version(context.Context, *emptypb.Empty) (*rpc.VersionInfo2, error) {
+	return &rpc.VersionInfo2{Name: "leader", Version: "v2.12.0"}, nil
+}
+
+func (leaderServer) GetIntercept(_ context.Context, rq *rpc.GetInterceptRequest) (*rpc.InterceptInfo, error) {
+	return nil, status.Errorf(codes.NotFound, "intercept %q not found", rq.Name)
+}
+
+func (leaderServer) Tunnel(server rpc.Manager_TunnelServer) error {
+	for {
+		msg, err := server.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if err = server.Send(msg); err != nil {
+			return err
+		}
+	}
+}
+
+// listen returns a listener on a random loopback port.
+func listen(t *testing.T) net.Listener {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	return ln
+}
+
+func TestForwarder(t *testing.T) {
+	ctx, cancel := context.WithCancel(dlog.NewTestContext(t, false))
+	defer cancel()
+
+	leaderLn := listen(t)
+	leaderSrv := grpc.NewServer()
+	rpc.RegisterManagerServer(leaderSrv, leaderServer{})
+	go func() { _ = leaderSrv.Serve(leaderLn) }()
+	defer leaderSrv.Stop()
+
+	ctx = k8sapi.WithK8sInterface(ctx, fake.NewSimpleClientset(&core.Pod{
+		ObjectMeta: meta.ObjectMeta{Name: "traffic-manager-a", Namespace: "ambassador"},
+		Status:     core.PodStatus{PodIP: "127.0.0.1"},
+	}))
+	fwd := NewForwarder("ambassador", uint16(leaderLn.Addr().(*net.TCPAddr).Port))
+	defer fwd.Close()
+	fwdLn := listen(t)
+	go func() { _ = fwd.Serve(ctx, fwdLn) }()
+
+	conn, err := grpc.DialContext(ctx, fwdLn.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := rpc.NewManagerClient(conn)
+
+	t.Run("no leader", func(t *testing.T) {
+		_, err := client.Version(ctx, &emptypb.Empty{})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+
+	fwd.SetLeader(ctx, "traffic-manager-a")
+
+	t.Run("unary", func(t *testing.T) {
+		vi, err := client.Version(ctx, &emptypb.Empty{})
+		require.NoError(t, err)
+		assert.Equal(t, "leader", vi.Name)
+		assert.Equal(t, "v2.12.0", vi.Version)
+	})
+
+	t.Run("status", func(t *testing.T) {
+		_, err := client.GetIntercept(ctx, &rpc.GetInterceptRequest{Name: "echo"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Equal(t, `intercept "echo" not found`, status.Convert(err).Message())
+	})
+
+	t.Run("unimplemented", func(t *testing.T) {
+		_, err := client.GetLicense(ctx, &emptypb.Empty{})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+
+	t.Run("tunnel", func(t *testing.T) {
+		stream, err := client.Tunnel(ctx)
+		require.NoError(t, err)
+		for _, payload := range []string{"hello", "world"} {
+			require.NoError(t, stream.Send(&rpc.TunnelMessage{Payload: []byte(payload)}))
+			msg, err := stream.Recv()
+			require.NoError(t, err)
+			assert.Equal(t, payload, string(msg.Payload))
+		}
+		require.NoError(t, stream.CloseSend())
+		_, err = stream.Recv()
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("unknown leader pod", func(t *testing.T) {
+		fwd.SetLeader(ctx, "traffic-manager-b")
+		_, err := client.Version(ctx, &emptypb.Empty{})
+		assert.NoError(t, err, "calls continue to the known leader until the new one is found")
+	})
+}
@@ -3,6 +3,7 @@ package manager
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health/grpc_health_v1"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 
@@ -24,10 +26,15 @@ import (
 	"github.com/datawire/dlib/dlog"
 	"github.com/datawire/k8sapi/pkg/k8sapi"
 	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/internal/interceptcrd"
+	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/internal/leader"
 	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/internal/mutator"
+	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/internal/persist"
+	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/internal/replica"
 	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/managerutil"
 	"github.com/telepresenceio/telepresence/v2/pkg/tracing"
 	"github.com/telepresenceio/telepresence/v2/pkg/version"
+	"github.com/telepresenceio/telepresence/v2/pkg/workload"
 )
 
 var (
@@ -71,12 +78,74 @@ func Main(ctx context.Context, _ ...string) error {
 		return fmt.Errorf("unable to create the Kubernetes Interface from InClusterConfig: %w", err)
 	}
 	ctx = k8sapi.WithK8sInterface(ctx, ki)
+	di, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return fmt.Errorf("unable to create the Kubernetes dynamic Interface from InClusterConfig: %w", err)
+	}
+	ctx = workload.WithDynamicInterface(ctx, di)
+
+	if env.LeaderElection {
+		if !env.StatePersistence {
+			dlog.Warn(ctx, "state persistence is disabled, so clients lose their intercepts when another replica takes over")
+		}
+		identity, err := os.Hostname()
+		if err != nil {
+			return fmt.Errorf("unable to obtain hostname: %w", err)
+		}
+		return runReplica(ctx, tracer, identity)
+	}
+	return serve(ctx, tracer)
+}
+
+// runReplica runs one of several replicas of the traffic-manager, with the given identity. The elected leader
+// serves, and the other replicas forward the calls of their clients and agents to it, so that clients and agents
+// can connect to any replica.
+func runReplica(ctx context.Context, tracer *tracing.TraceServer, identity string) error {
+	env := managerutil.GetEnv(ctx)
+	var dialOpts []grpc.DialOption
+	if mz, ok := env.MaxReceiveSize.AsInt64(); ok {
+		dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(int(mz))))
+	}
+	fwd := replica.NewForwarder(env.ManagerNamespace, env.ServerPort, dialOpts...)
+	defer fwd.Close()
+
+	ln, err := net.Listen("tcp", fmt.Sprintf("%s:%d", env.ServerHost, env.ServerPort))
+	if err != nil {
+		return err
+	}
+	fwdCtx, stopFwd := context.WithCancel(ctx)
+	defer stopFwd()
+	fwdDone := make(chan struct{})
+	go func() {
+		defer close(fwdDone)
+		if err := fwd.Serve(fwdCtx, ln, grpcServerOptions(env)...); err != nil {
+			dlog.Errorf(ctx, "unable to forward calls to the traffic-manager leader: %v", err)
+		}
+	}()
+	return leader.Run(ctx, env.ManagerNamespace, identity, func(ctx context.Context) error {
+		// The leader serves on the port that the forwarder listened on.
+		stopFwd()
+		<-fwdDone
+		return serve(ctx, tracer)
+	}, fwd.SetLeader)
+}
 
+// serve starts the traffic-manager's servers and loops, and blocks until they end.
+func serve(ctx context.Context, tracer *tracing.TraceServer) error {
+	env := managerutil.GetEnv(ctx)
 	mgr, ctx, err := NewServiceFunc(ctx)
 	if err != nil {
 		return fmt.Errorf("unable to initialize traffic manager: %w", err)
 	}
 	ctx, imgRetErr := managerutil.WithAgentImageRetriever(ctx, mutator.RegenerateAgentMaps)
+	ctx = mutator.WithAgentUsage(ctx, mgr.State())
+
+	if env.StatePersistence {
+		// Restore the state before the clients can reach the manager, so that they find their sessions.
+		if err = persist.Load(ctx, mgr.State(), env.ManagerNamespace); err != nil {
+			dlog.Errorf(ctx, "unable to restore the traffic-manager state: %v", err)
+		}
+	}
 
 	g := dgroup.NewGroup(ctx, dgroup.GroupConfig{
 		EnableSignalHandling: true,
@@ -100,6 +169,16 @@ func Main(ctx context.Context, _ ...string) error {
 
 	g.Go("session-gc", mgr.RunSessionGCLoop)
 
+	g.Go("intercept-reconciler", func(ctx context.Context) error {
+		return interceptcrd.Run(ctx, mgr)
+	})
+
+	if env.StatePersistence {
+		g.Go("state-persister", func(ctx context.Context) error {
+			return persist.Run(ctx, mgr.State(), env.ManagerNamespace)
+		})
+	}
+
 	if tracer != nil {
 		g.Go("tracer-grpc", func(c context.Context) error {
 			return tracer.ServeGrpc(c, env.TracingGrpcPort)
@@ -121,6 +200,9 @@ func (m *service) ServePrometheus(ctx context.Context) error {
 		}, func() float64 {
 			return float64(m.state.CountAllClients())
 		})
+		if err := m.state.RegisterMetrics(prometheus.DefaultRegisterer); err != nil {
+			return fmt.Errorf("unable to register traffic-manager metrics: %w", err)
+		}
 
 		sc := &dhttp.ServerConfig{
 			Handler: promhttp.Handler(),
@@ -136,15 +218,7 @@ func serveHTTP(ctx context.Context, m Service) error {
 	env := managerutil.GetEnv(ctx)
 	host := env.ServerHost
 	port := env.ServerPort
-	opts := []grpc.ServerOption{
-		grpc.UnaryInterceptor(otelgrpc.UnaryServerInterceptor()),
-		grpc.StreamInterceptor(otelgrpc.StreamServerInterceptor()),
-	}
-	if mz, ok := env.MaxReceiveSize.AsInt64(); ok {
-		opts = append(opts, grpc.MaxRecvMsgSize(int(mz)))
-	}
-
-	grpcHandler := grpc.NewServer(opts...)
+	grpcHandler := grpc.NewServer(grpcServerOptions(env)...)
 	httpHandler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprintf(w, "Hello World from: %s\n", r.URL.Path)
 	}))
@@ -161,6 +235,18 @@ func serveHTTP(ctx context.Context, m Service) error {
 	return sc.ListenAndServe(ctx, fmt.Sprintf("%s:%d", host, port))
 }
 
+// grpcServerOptions returns the options of the traffic-manager's gRPC server.
+func grpcServerOptions(env *managerutil.Env) []grpc.ServerOption {
+	opts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(otelgrpc.UnaryServerInterceptor()),
+		grpc.StreamInterceptor(otelgrpc.StreamServerInterceptor()),
+	}
+	if mz, ok := env.MaxReceiveSize.AsInt64(); ok {
+		opts = append(opts, grpc.MaxRecvMsgSize(int(mz)))
+	}
+	return opts
+}
+
 func (m *service) RegisterServers(grpcHandler *grpc.Server) {
 	rpc.RegisterManagerServer(grpcHandler, m)
 	grpc_health_v1.RegisterHealthServer(grpcHandler, &HealthChecker{})
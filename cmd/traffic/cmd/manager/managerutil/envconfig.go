@@ -29,18 +29,23 @@ import (
 // The Env is responsible for all parsing of the environment strings. No parsing of such
 // strings should be made elsewhere in the code.
 type Env struct {
-	LogLevel               string   `env:"LOG_LEVEL,                parser=logLevel"`
-	User                   string   `env:"USER,                     parser=string,      default="`
-	ServerHost             string   `env:"SERVER_HOST,              parser=string,      default="`
-	ServerPort             uint16   `env:"SERVER_PORT,              parser=port-number"`
-	PrometheusPort         uint16   `env:"PROMETHEUS_PORT,          parser=port-number, default=0"`
-	MutatorWebhookPort     uint16   `env:"MUTATOR_WEBHOOK_PORT,     parser=port-number, default=0"`
-	SystemAHost            string   `env:"SYSTEMA_HOST,             parser=string,      default="`
-	SystemAPort            uint16   `env:"SYSTEMA_PORT,             parser=port-number, default=0"`
-	ManagerNamespace       string   `env:"MANAGER_NAMESPACE,        parser=string,      default="`
-	ManagedNamespaces      []string `env:"MANAGED_NAMESPACES,       parser=split-trim,  default="`
-	APIPort                uint16   `env:"AGENT_REST_API_PORT,      parser=port-number, default=0"`
-	InterceptDisableGlobal bool     `env:"INTERCEPT_DISABLE_GLOBAL, parser=bool"`
+	LogLevel               string        `env:"LOG_LEVEL,                parser=logLevel"`
+	User                   string        `env:"USER,                     parser=string,      default="`
+	ServerHost             string        `env:"SERVER_HOST,              parser=string,      default="`
+	ServerPort             uint16        `env:"SERVER_PORT,              parser=port-number"`
+	PrometheusPort         uint16        `env:"PROMETHEUS_PORT,          parser=port-number, default=0"`
+	MutatorWebhookPort     uint16        `env:"MUTATOR_WEBHOOK_PORT,     parser=port-number, default=0"`
+	SystemAHost            string        `env:"SYSTEMA_HOST,             parser=string,      default="`
+	SystemAPort            uint16        `env:"SYSTEMA_PORT,             parser=port-number, default=0"`
+	ManagerNamespace       string        `env:"MANAGER_NAMESPACE,        parser=string,      default="`
+	ManagedNamespaces      []string      `env:"MANAGED_NAMESPACES,       parser=split-trim,  default="`
+	APIPort                uint16        `env:"AGENT_REST_API_PORT,      parser=port-number, default=0"`
+	InterceptDisableGlobal bool          `env:"INTERCEPT_DISABLE_GLOBAL, parser=bool"`
+	InterceptMaxTTL        time.Duration `env:"INTERCEPT_MAX_TTL,        parser=time.ParseDuration, default=0s"`
+	AuditLog               string        `env:"AUDIT_LOG,                parser=string,      default="`
+	AuditEvents            bool          `env:"AUDIT_EVENTS,             parser=bool,        default=false"`
+	StatePersistence       bool          `env:"STATE_PERSISTENCE,        parser=bool,        default=false"`
+	LeaderElection         bool          `env:"LEADER_ELECTION,          parser=bool,        default=false"`
 
 	TracingGrpcPort uint16            `env:"TRACING_GRPC_PORT,     parser=port-number,default=0"`
 	MaxReceiveSize  resource.Quantity `env:"GRPC_MAX_RECEIVE_SIZE, parser=quantity"`
@@ -53,6 +58,7 @@ type Env struct {
 	AgentImage               string                      `env:"AGENT_IMAGE,              parser=string,         default="`
 	AgentImagePullSecrets    []core.LocalObjectReference `env:"AGENT_IMAGE_PULL_SECRETS, parser=json-local-refs,default="`
 	AgentInjectPolicy        agentconfig.InjectPolicy    `env:"AGENT_INJECT_POLICY,      parser=enable-policy"`
+	AgentSidecarMode         agentconfig.SidecarMode     `env:"AGENT_SIDECAR_MODE,       parser=sidecar-mode,   default=Auto"`
 	AgentAppProtocolStrategy k8sapi.AppProtocolStrategy  `env:"AGENT_APP_PROTO_STRATEGY, parser=app-proto-strategy"`
 	AgentLogLevel            string                      `env:"AGENT_LOG_LEVEL,          parser=logLevel,       defaultFrom=LogLevel"`
 	AgentPort                uint16                      `env:"AGENT_PORT,               parser=port-number"`
@@ -62,6 +68,7 @@ type Env struct {
 	AgentEnvoyServerPort     uint16                      `env:"AGENT_ENVOY_SERVER_PORT,  parser=port-number"`
 	AgentEnvoyAdminPort      uint16                      `env:"AGENT_ENVOY_ADMIN_PORT,   parser=port-number"`
 	AgentInjectorName        string                      `env:"AGENT_INJECTOR_NAME,      parser=string"`
+	AgentIdleTimeout         time.Duration               `env:"AGENT_IDLE_TIMEOUT,       parser=time.ParseDuration, default=0s"`
 
 	ClientRoutingAlsoProxySubnets  []*net.IPNet  `env:"CLIENT_ROUTING_ALSO_PROXY_SUBNETS,  parser=split-ipnet, default="`
 	ClientRoutingNeverProxySubnets []*net.IPNet  `env:"CLIENT_ROUTING_NEVER_PROXY_SUBNETS, parser=split-ipnet, default="`
@@ -130,6 +137,14 @@ func fieldTypeHandlers() map[reflect.Type]envconfig.FieldTypeHandler {
 		},
 		Setter: func(dst reflect.Value, src interface{}) { dst.SetInt(int64(src.(agentconfig.InjectPolicy))) },
 	}
+	fhs[reflect.TypeOf(agentconfig.SidecarMode(0))] = envconfig.FieldTypeHandler{
+		Parsers: map[string]func(string) (any, error){
+			"sidecar-mode": func(str string) (any, error) {
+				return agentconfig.NewSidecarMode(str)
+			},
+		},
+		Setter: func(dst reflect.Value, src interface{}) { dst.SetInt(int64(src.(agentconfig.SidecarMode))) },
+	}
 	fhs[reflect.TypeOf(resource.Quantity{})] = envconfig.FieldTypeHandler{
 		Parsers: map[string]func(string) (any, error){
 			"quantity": func(str string) (any, error) {
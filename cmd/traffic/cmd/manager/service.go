@@ -9,8 +9,10 @@ import (
 	"path"
 	"sort"
 	"strconv"
+	"sync"
 	"time"
 
+	"github.com/blang/semver"
 	"github.com/google/uuid"
 	dns2 "github.com/miekg/dns"
 	"go.opentelemetry.io/otel/trace"
@@ -18,19 +20,26 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	empty "google.golang.org/protobuf/types/known/emptypb"
+	authv1 "k8s.io/api/authentication/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/k8sapi/pkg/k8sapi"
 	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
 	"github.com/telepresenceio/telepresence/rpc/v2/systema"
 	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/internal/ambassadoragent/cloudtoken"
+	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/internal/audit"
 	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/internal/cluster"
 	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/internal/config"
+	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/internal/interceptcrd"
 	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/license"
 	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/managerutil"
 	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/state"
 	"github.com/telepresenceio/telepresence/v2/pkg/a8rcloud"
 	"github.com/telepresenceio/telepresence/v2/pkg/dnsproxy"
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
 	"github.com/telepresenceio/telepresence/v2/pkg/iputil"
 	"github.com/telepresenceio/telepresence/v2/pkg/tracing"
 	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
@@ -62,6 +71,11 @@ type service struct {
 	cloudConfig   *rpc.AmbassadorCloudConfig
 	configWatcher config.Watcher
 	tokenService  cloudtoken.Service
+	audit         *audit.Log
+
+	// drains are the intercepts that RemoveIntercept is draining, keyed by intercept ID.
+	drainMu sync.Mutex
+	drains  map[string]*interceptDrain
 
 	rpc.UnsafeManagerServer
 }
@@ -117,7 +131,11 @@ func NewService(ctx context.Context) (Service, context.Context, error) {
 		ctx = a8rcloud.WithSystemAPool[managerutil.SystemaCRUDClient](ctx, a8rcloud.UnauthdTrafficManagerConnName, &managerutil.UnauthdConnProvider{Config: cloudConfig})
 		ctx = a8rcloud.WithSystemAPool[managerutil.SystemaCRUDClient](ctx, a8rcloud.TrafficManagerConnName, &ReverseConnProvider{ret})
 	}
-	ret.configWatcher = config.NewWatcher(managerutil.GetEnv(ctx).ManagerNamespace)
+	env := managerutil.GetEnv(ctx)
+	if ret.audit, err = audit.NewLog(ctx, env.AuditLog, env.AuditEvents); err != nil {
+		return nil, nil, fmt.Errorf("unable to open audit log: %w", err)
+	}
+	ret.configWatcher = config.NewWatcher(env.ManagerNamespace)
 	ret.ctx = ctx
 	// These are context dependent so build them once the pool is up
 	ret.clusterInfo = cluster.NewInfo(ctx)
@@ -202,10 +220,22 @@ func (m *service) ArriveAsClient(ctx context.Context, client *rpc.ClientInfo) (*
 	dlog.Debug(ctx, "ArriveAsClient called")
 
 	if val := validateClient(client); val != "" {
-		return nil, status.Errorf(codes.InvalidArgument, val)
+		err := status.Errorf(codes.InvalidArgument, val)
+		m.audit.Record(ctx, audit.NewEntry(audit.ActionArrive, "", client).WithError(err))
+		return nil, err
 	}
 
+	reviewClientIdentity(ctx, client)
+	if err := m.checkEgressPolicy(client); err != nil {
+		dlog.Info(ctx, err)
+		err = status.Error(codes.PermissionDenied, err.Error())
+		e := audit.NewEntry(audit.ActionArrive, "", client).WithError(err)
+		e.Outcome = audit.OutcomeDenied
+		m.audit.Record(ctx, e)
+		return nil, err
+	}
 	sessionID := m.state.AddClient(client, m.clock.Now())
+	m.audit.Record(ctx, audit.NewEntry(audit.ActionArrive, sessionID, client))
 	m.MaybeAddToken(ctx, client.GetApiKey())
 
 	installId := client.GetInstallId()
@@ -216,6 +246,31 @@ func (m *service) ArriveAsClient(ctx context.Context, client *rpc.ClientInfo) (*
 	}, nil
 }
 
+// reviewClientIdentity replaces the user and groups of the given client with the ones that the
+// Kubernetes API server returns in a TokenReview of the client's kube_token, and then discards the
+// token. The user and groups are left empty when the client has no token, or when the review fails.
+func reviewClientIdentity(ctx context.Context, client *rpc.ClientInfo) {
+	token := client.KubeToken
+	client.KubeToken = ""
+	client.KubeUser = ""
+	client.KubeGroups = nil
+	if token == "" {
+		return
+	}
+	tr, err := k8sapi.GetK8sInterface(ctx).AuthenticationV1().TokenReviews().Create(ctx, &authv1.TokenReview{
+		Spec: authv1.TokenReviewSpec{Token: token},
+	}, meta.CreateOptions{})
+	switch {
+	case err != nil:
+		dlog.Errorf(ctx, "unable to review the kubernetes token of client %s: %v", client.Name, err)
+	case !tr.Status.Authenticated:
+		dlog.Warnf(ctx, "the kubernetes token of client %s is not authenticated: %s", client.Name, tr.Status.Error)
+	default:
+		client.KubeUser = tr.Status.User.Username
+		client.KubeGroups = tr.Status.User.Groups
+	}
+}
+
 // ArriveAsAgent establishes a session between an agent and the Manager.
 func (m *service) ArriveAsAgent(ctx context.Context, agent *rpc.AgentInfo) (*rpc.SessionInfo, error) {
 	dlog.Debug(ctx, "ArriveAsAgent called")
@@ -258,6 +313,10 @@ func (m *service) Depart(ctx context.Context, session *rpc.SessionInfo) (*empty.
 	ctx = managerutil.WithSessionInfo(ctx, session)
 	dlog.Debug(ctx, "Depart called")
 
+	// Agents depart too, but only client sessions are audited.
+	if client := m.state.GetClient(session.GetSessionId()); client != nil {
+		m.audit.Record(ctx, audit.NewEntry(audit.ActionDepart, session.GetSessionId(), client))
+	}
 	m.state.RemoveSession(ctx, session.GetSessionId())
 
 	return &empty.Empty{}, nil
@@ -429,10 +488,17 @@ func (m *service) WatchIntercepts(session *rpc.SessionInfo, stream rpc.Manager_W
 					dlog.Debugf(ctx, "Intercept mismatch: %s.%s != %s.%s", info.Spec.Agent, info.Spec.Namespace, agent.Name, agent.Namespace)
 					return false
 				}
+				// Don't return intercepts that are limited to other replicas.
+				if !state.AgentServesIntercept(agent, info) {
+					dlog.Debugf(ctx, "Intercept %s.%s is not served by pod %s", info.Spec.Agent, info.Spec.Namespace, agent.PodName)
+					return false
+				}
 				// Don't return intercepts that aren't in a "agent-owned" state.
 				switch info.Disposition {
 				case rpc.InterceptDispositionType_WAITING,
 					rpc.InterceptDispositionType_ACTIVE,
+					rpc.InterceptDispositionType_PAUSED,
+					rpc.InterceptDispositionType_DRAINING,
 					rpc.InterceptDispositionType_AGENT_ERROR:
 					// agent-owned state: include the intercept
 					dlog.Debugf(ctx, "Intercept %s.%s valid. Disposition: %s", info.Spec.Agent, info.Spec.Namespace, info.Disposition)
@@ -491,11 +557,106 @@ func (m *service) PrepareIntercept(ctx context.Context, request *rpc.CreateInter
 	span := trace.SpanFromContext(ctx)
 	tracing.RecordInterceptSpec(span, request.InterceptSpec)
 
+	// The service port isn't resolved until the intercept is prepared, so this check only denies
+	// intercepts that the policy would deny regardless of port. CreateIntercept performs the full check.
+	if err := m.checkInterceptPolicy(ctx, request.GetSession().GetSessionId(), request.InterceptSpec, nil); err != nil {
+		dlog.Info(ctx, err)
+		m.auditIntercept(ctx, audit.ActionCreateIntercept, request.GetSession().GetSessionId(), request.InterceptSpec, err)
+		return &rpc.PreparedIntercept{Error: err.Error(), ErrorCategory: int32(errcat.GetCategory(err))}, nil
+	}
 	return m.state.PrepareIntercept(ctx, request)
 }
 
+// checkInterceptPolicy returns an errcat.User error if the intercept policy doesn't allow the client
+// with the given session ID to create the given intercept using one of the given service ports. When
+// the intercept is created from an Intercept resource, the policy must also allow the requester of
+// the resource to create it.
+//
+// The user and groups of the client are the ones that reviewClientIdentity established when the client
+// arrived. Those of the requester are the ones that the mutating webhook recorded on the resource.
+func (m *service) checkInterceptPolicy(ctx context.Context, sessionID string, spec *rpc.InterceptSpec, ports []string) error {
+	policy, err := m.configWatcher.GetInterceptPolicy()
+	if err != nil {
+		return errcat.User.Newf("the intercept policy of the traffic-manager is invalid: %v", err)
+	}
+	if policy == nil {
+		return nil
+	}
+	// An unknown session is checked as an unidentified user.
+	client := m.state.GetClient(sessionID)
+	ir := &config.InterceptRequest{
+		User:      client.GetKubeUser(),
+		Groups:    client.GetKubeGroups(),
+		Namespace: spec.Namespace,
+		Workload:  spec.Agent,
+		Mechanism: spec.Mechanism,
+		Ports:     ports,
+	}
+	if err = policy.Check(ir); err != nil {
+		return err
+	}
+	if _, rq := interceptcrd.GetRequester(ctx); rq != nil {
+		ir.User = rq.User
+		ir.Groups = rq.Groups
+		return policy.Check(ir)
+	}
+	return nil
+}
+
+// checkEgressPolicy returns an errcat.User error if the intercept policy doesn't allow the given client to
+// route its outbound connections through the traffic-agent of its egress workload. The policy checks this
+// as an intercept of the workload that uses the config.EgressMechanism, so that only rules that don't limit
+// the mechanisms, or that list "egress", will allow it.
+func (m *service) checkEgressPolicy(client *rpc.ClientInfo) error {
+	if client.EgressWorkload == "" {
+		return nil
+	}
+	policy, err := m.configWatcher.GetInterceptPolicy()
+	if err != nil {
+		return errcat.User.Newf("the intercept policy of the traffic-manager is invalid: %v", err)
+	}
+	if policy == nil {
+		return nil
+	}
+	err = policy.Check(&config.InterceptRequest{
+		User:      client.KubeUser,
+		Groups:    client.KubeGroups,
+		Namespace: client.EgressNamespace,
+		Workload:  client.EgressWorkload,
+		Mechanism: config.EgressMechanism,
+	})
+	if err != nil {
+		return errcat.User.Newf("unable to route outbound connections through %s.%s: %v",
+			client.EgressWorkload, client.EgressNamespace, err)
+	}
+	return nil
+}
+
+// auditIntercept records an action on the intercept with the given spec, created by the client
+// with the given session ID. The Intercept resource and its requester are recorded when the action
+// is taken on behalf of a resource. An error with code PermissionDenied, or one that is returned by
+// the intercept policy, is recorded as a denial.
+func (m *service) auditIntercept(ctx context.Context, action, sessionID string, spec *rpc.InterceptSpec, err error) {
+	e := audit.NewEntry(action, sessionID, m.state.GetClient(sessionID)).
+		WithIntercept(sessionID+":"+spec.GetName(), spec).
+		WithError(err)
+	if resource, rq := interceptcrd.GetRequester(ctx); rq != nil {
+		e.WithResource(resource, rq.User)
+	}
+	if err != nil && (status.Code(err) == codes.PermissionDenied || errcat.GetCategory(err) == errcat.User) {
+		e.Outcome = audit.OutcomeDenied
+	}
+	m.audit.Record(ctx, e)
+}
+
 // CreateIntercept lets a client create an intercept.
 func (m *service) CreateIntercept(ctx context.Context, ciReq *rpc.CreateInterceptRequest) (*rpc.InterceptInfo, error) {
+	ii, err := m.createIntercept(ctx, ciReq)
+	m.auditIntercept(ctx, audit.ActionCreateIntercept, ciReq.GetSession().GetSessionId(), ciReq.InterceptSpec, err)
+	return ii, err
+}
+
+func (m *service) createIntercept(ctx context.Context, ciReq *rpc.CreateInterceptRequest) (*rpc.InterceptInfo, error) {
 	ctx = managerutil.WithSessionInfo(ctx, ciReq.GetSession())
 	sessionID := ciReq.GetSession().GetSessionId()
 	spec := ciReq.InterceptSpec
@@ -513,8 +674,27 @@ func (m *service) CreateIntercept(ctx context.Context, ciReq *rpc.CreateIntercep
 	if val := validateIntercept(spec); val != "" {
 		return nil, status.Errorf(codes.InvalidArgument, val)
 	}
+	var ports []string
+	if spec.ServicePortName != "" {
+		ports = append(ports, spec.ServicePortName)
+	}
+	if spec.ServicePort > 0 {
+		ports = append(ports, strconv.Itoa(int(spec.ServicePort)))
+	}
+	if err := m.checkInterceptPolicy(ctx, sessionID, spec, ports); err != nil {
+		dlog.Info(ctx, err)
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if maxTTL := managerutil.GetEnv(ctx).InterceptMaxTTL; maxTTL > 0 {
+		if ttl := spec.Ttl.AsDuration(); ttl == 0 || ttl > maxTTL {
+			dlog.Debugf(ctx, "Limiting time-to-live of intercept %s to %s", spec.Name, maxTTL)
+			// Clamp a copy. The spec belongs to the caller's request.
+			spec = proto.Clone(spec).(*rpc.InterceptSpec)
+			spec.Ttl = durationpb.New(maxTTL)
+		}
+	}
 
-	interceptInfo, err := m.state.AddIntercept(sessionID, m.clusterInfo.GetClusterID(), apiKey, client, spec)
+	interceptInfo, err := m.state.AddIntercept(sessionID, m.clusterInfo.GetClusterID(), apiKey, client, spec, m.clock.Now())
 	if err != nil {
 		return nil, err
 	}
@@ -577,7 +757,17 @@ func (m *service) makeinterceptID(_ context.Context, sessionID string, name stri
 
 const systemaCallTimeout = 3 * time.Second
 
-func (m *service) UpdateIntercept(ctx context.Context, req *rpc.UpdateInterceptRequest) (*rpc.InterceptInfo, error) { //nolint:gocognit
+func (m *service) UpdateIntercept(ctx context.Context, req *rpc.UpdateInterceptRequest) (*rpc.InterceptInfo, error) {
+	ii, err := m.updateIntercept(ctx, req)
+	spec := ii.GetSpec()
+	if spec == nil {
+		spec = &rpc.InterceptSpec{Name: req.GetName()}
+	}
+	m.auditIntercept(ctx, audit.ActionUpdateIntercept, req.GetSession().GetSessionId(), spec, err)
+	return ii, err
+}
+
+func (m *service) updateIntercept(ctx context.Context, req *rpc.UpdateInterceptRequest) (*rpc.InterceptInfo, error) { //nolint:gocognit
 	ctx = managerutil.WithSessionInfo(ctx, req.GetSession())
 	interceptID, err := m.makeinterceptID(ctx, req.GetSession().GetSessionId(), req.GetName())
 	if err != nil {
@@ -586,6 +776,13 @@ func (m *service) UpdateIntercept(ctx context.Context, req *rpc.UpdateInterceptR
 
 	dlog.Debugf(ctx, "UpdateIntercept called: %s", interceptID)
 
+	if action, ok := req.PauseAction.(*rpc.UpdateInterceptRequest_Pause); ok {
+		return m.pauseIntercept(ctx, interceptID, action.Pause)
+	}
+	if action, ok := req.PauseAction.(*rpc.UpdateInterceptRequest_Resume); ok {
+		return m.pauseIntercept(ctx, interceptID, !action.Resume)
+	}
+
 	switch action := req.PreviewDomainAction.(type) {
 	case *rpc.UpdateInterceptRequest_AddPreviewDomain:
 		// Check if this is already done.
@@ -611,6 +808,35 @@ func (m *service) UpdateIntercept(ctx context.Context, req *rpc.UpdateInterceptR
 	}
 }
 
+// pauseIntercept changes the disposition of an ACTIVE intercept to PAUSED, or the disposition of a
+// PAUSED intercept to ACTIVE. The agent sends the traffic of a PAUSED intercept to the intercepted
+// container, but the intercept remains in place.
+func (m *service) pauseIntercept(ctx context.Context, interceptID string, pause bool) (*rpc.InterceptInfo, error) {
+	from, to := rpc.InterceptDispositionType_PAUSED, rpc.InterceptDispositionType_ACTIVE
+	if pause {
+		from, to = to, from
+	}
+	var current rpc.InterceptDispositionType
+	intercept := m.state.UpdateIntercept(interceptID, func(intercept *rpc.InterceptInfo) {
+		current = intercept.Disposition
+		if current == from {
+			intercept.Disposition = to
+		}
+	})
+	if intercept == nil {
+		return nil, status.Errorf(codes.NotFound, "Intercept with ID %q not found for this session", interceptID)
+	}
+	switch current {
+	case from:
+		dlog.Infof(ctx, "Intercept %s is %s", interceptID, to)
+	case to:
+		// Already done
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition, "intercept %q is %s, not %s", intercept.Spec.Name, current, from)
+	}
+	return intercept, nil
+}
+
 func (m *service) removeInterceptDomain(ctx context.Context, interceptID string) (*rpc.InterceptInfo, error) {
 	var domain string
 	systemaPool, ok := a8rcloud.GetSystemAPool[managerutil.SystemaCRUDClient](ctx, a8rcloud.TrafficManagerConnName)
@@ -760,13 +986,128 @@ func (m *service) RemoveIntercept(ctx context.Context, riReq *rpc.RemoveIntercep
 		return nil, status.Errorf(codes.NotFound, "Client session %q not found", sessionID)
 	}
 
-	if !m.state.RemoveIntercept(sessionID + ":" + name) {
-		return nil, status.Errorf(codes.NotFound, "Intercept named %q not found", name)
+	interceptID := sessionID + ":" + name
+	spec := &rpc.InterceptSpec{Name: name}
+	if ii, ok := m.state.GetIntercept(interceptID); ok {
+		spec = ii.Spec
+	}
+	if drain := riReq.DrainTimeout.AsDuration(); drain > 0 {
+		m.drainIntercept(ctx, interceptID, riReq.DrainTimeout)
+	}
+	if !m.state.RemoveIntercept(interceptID) {
+		err := status.Errorf(codes.NotFound, "Intercept named %q not found", name)
+		m.auditIntercept(ctx, audit.ActionRemoveIntercept, sessionID, spec, err)
+		return nil, err
 	}
+	m.auditIntercept(ctx, audit.ActionRemoveIntercept, sessionID, spec, nil)
 
 	return &empty.Empty{}, nil
 }
 
+// firstDrainReportVersion is the first traffic-agent version that reports when an intercept is drained.
+var firstDrainReportVersion = semver.MustParse("2.13.0") //nolint:gochecknoglobals // constant
+
+// drainRecheckInterval is the interval between the checks of a drain that doesn't get any reports,
+// e.g. because the agents that serve the intercept have departed.
+const drainRecheckInterval = time.Second
+
+// interceptDrain is an intercept that RemoveIntercept is draining.
+type interceptDrain struct {
+	// agents are the sessions of the agents that have reported that the intercept is drained. Guarded by
+	// the drainMu of the service.
+	agents map[string]struct{}
+
+	// reported receives a signal when an agent has reported.
+	reported chan struct{}
+}
+
+// reportsDrained returns false if the given agent is a Telepresence 2 release that predates the reports
+// of drained intercepts. Agents with other versions, such as development builds, are assumed to report.
+func reportsDrained(agent *rpc.AgentInfo) bool {
+	v, err := semver.ParseTolerant(agent.Version)
+	if err != nil || v.Major != firstDrainReportVersion.Major {
+		return true
+	}
+	v.Pre, v.Build = nil, nil
+	return v.GE(firstDrainReportVersion)
+}
+
+// drainIntercept changes the disposition of an ACTIVE intercept to DRAINING, so that the agents send new
+// connections to the intercepted container, and then waits until all agents that serve the intercept
+// report that the connections that are tunneled to the client have closed, or until the drain timeout
+// has passed. Agents that are too old to report are not waited for, so the call returns immediately
+// when no agent that can report serves the intercept.
+func (m *service) drainIntercept(ctx context.Context, interceptID string, timeout *durationpb.Duration) {
+	// Register for reports before the agents learn that the intercept is draining.
+	d := &interceptDrain{agents: make(map[string]struct{}), reported: make(chan struct{}, 1)}
+	m.drainMu.Lock()
+	if m.drains == nil {
+		m.drains = make(map[string]*interceptDrain)
+	}
+	m.drains[interceptID] = d
+	m.drainMu.Unlock()
+	defer func() {
+		m.drainMu.Lock()
+		delete(m.drains, interceptID)
+		m.drainMu.Unlock()
+	}()
+
+	var spec *rpc.InterceptSpec
+	m.state.UpdateIntercept(interceptID, func(intercept *rpc.InterceptInfo) {
+		spec = nil
+		if intercept.Disposition == rpc.InterceptDispositionType_ACTIVE && !intercept.Spec.Mirror {
+			spec = intercept.Spec
+			intercept.Disposition = rpc.InterceptDispositionType_DRAINING
+			intercept.DrainTimeout = timeout
+		}
+	})
+	if spec == nil {
+		return
+	}
+
+	isDrained := func() bool {
+		ii, ok := m.state.GetIntercept(interceptID)
+		if !ok {
+			return true
+		}
+		m.drainMu.Lock()
+		defer m.drainMu.Unlock()
+		for agentSessionID, agent := range m.state.GetAgentsByName(spec.Agent, spec.Namespace) {
+			if !state.AgentServesIntercept(agent, ii) || !reportsDrained(agent) {
+				continue
+			}
+			if _, ok := d.agents[agentSessionID]; !ok {
+				return false
+			}
+		}
+		return true
+	}
+	if isDrained() {
+		dlog.Infof(ctx, "Intercept %s has no agents that can drain it", interceptID)
+		return
+	}
+	dlog.Infof(ctx, "Intercept %s is draining", interceptID)
+
+	// The intercept is removed when the timeout passes, or when the client gives up waiting.
+	ctx, cancel := context.WithTimeout(ctx, timeout.AsDuration())
+	defer cancel()
+	ticker := time.NewTicker(drainRecheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			dlog.Infof(ctx, "Intercept %s was not drained within %s", interceptID, timeout.AsDuration())
+			return
+		case <-d.reported:
+		case <-ticker.C:
+		}
+		if isDrained() {
+			dlog.Infof(ctx, "Intercept %s is drained", interceptID)
+			return
+		}
+	}
+}
+
 // GetIntercept gets an intercept info from intercept name.
 func (m *service) GetIntercept(ctx context.Context, request *rpc.GetInterceptRequest) (*rpc.InterceptInfo, error) {
 	interceptID, err := m.makeinterceptID(ctx, request.GetSession().GetSessionId(), request.GetName())
@@ -793,42 +1134,117 @@ func (m *service) ReviewIntercept(ctx context.Context, rIReq *rpc.ReviewIntercep
 		return nil, status.Errorf(codes.NotFound, "Agent session %q not found", sessionID)
 	}
 
+	if rIReq.HealthReport {
+		return m.reportInterceptHealth(ctx, agent, rIReq)
+	}
+
+	drainReport := false
+	reviewed := false
 	intercept := m.state.UpdateIntercept(ceptID, func(intercept *rpc.InterceptInfo) {
 		// Sanity check: The reviewing agent must be an agent for the intercept.
-		if intercept.Spec.Namespace != agent.Namespace || intercept.Spec.Agent != agent.Name {
+		if intercept.Spec.Namespace != agent.Namespace || intercept.Spec.Agent != agent.Name || !state.AgentServesIntercept(agent, intercept) {
 			return
 		}
 
 		// Only update intercepts in the waiting state.  Agents race to review an intercept, but we
 		// expect they will always compatible answers.
 		if intercept.Disposition == rpc.InterceptDispositionType_WAITING {
+			reviewed = rIReq.Disposition != rpc.InterceptDispositionType_WAITING
 			intercept.Disposition = rIReq.Disposition
 			intercept.Message = rIReq.Message
 			intercept.PodIp = rIReq.PodIp
 			intercept.FtpPort = rIReq.FtpPort
 			intercept.SftpPort = rIReq.SftpPort
+			intercept.GrpcFsPort = rIReq.GrpcFsPort
 			intercept.MountPoint = rIReq.MountPoint
 			intercept.MechanismArgsDesc = rIReq.MechanismArgsDesc
 			intercept.Headers = rIReq.Headers
 			intercept.Metadata = rIReq.Metadata
 			intercept.Environment = rIReq.Environment
+		} else if intercept.Disposition == rpc.InterceptDispositionType_DRAINING && rIReq.Drained {
+			drainReport = true
 		}
 	})
 
 	if intercept == nil {
 		return nil, status.Errorf(codes.NotFound, "Intercept with ID %q not found for this session", ceptID)
 	}
+	if drainReport {
+		// The client's call to RemoveIntercept is waiting for the reports of the agents.
+		m.drainMu.Lock()
+		if d := m.drains[ceptID]; d != nil {
+			d.agents[sessionID] = struct{}{}
+			select {
+			case d.reported <- struct{}{}:
+			default:
+				// The drain hasn't seen the previous report yet, and will see this one too.
+			}
+		}
+		m.drainMu.Unlock()
+		return &empty.Empty{}, nil
+	}
+	if !reviewed {
+		// The review was ignored, or another agent of the workload reviewed the intercept first.
+		return &empty.Empty{}, nil
+	}
+
+	// The entry is about the client that created the intercept. The outcome is the review of the agent.
+	clientSessionID := intercept.ClientSession.GetSessionId()
+	e := audit.NewEntry(audit.ActionReviewIntercept, clientSessionID, m.state.GetClient(clientSessionID)).
+		WithIntercept(intercept.Id, intercept.Spec)
+	if rIReq.Disposition != rpc.InterceptDispositionType_ACTIVE {
+		e.Outcome = audit.OutcomeFailure
+		e.Reason = rIReq.Disposition.String()
+		if rIReq.Message != "" {
+			e.Reason += ": " + rIReq.Message
+		}
+	}
+	m.audit.Record(ctx, e)
 
 	return &empty.Empty{}, nil
 }
 
+// reportInterceptHealth records the agent's report that it started or stopped sending the traffic of an
+// ACTIVE intercept to the intercepted container, because the intercept's target is unreachable.
+func (m *service) reportInterceptHealth(ctx context.Context, agent *rpc.AgentInfo, rIReq *rpc.ReviewInterceptRequest) (*empty.Empty, error) {
+	ceptID := rIReq.Id
+	intercept, ok := m.state.GetIntercept(ceptID)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "Intercept with ID %q not found for this session", ceptID)
+	}
+	// Sanity check: The reporting agent must be an agent for the intercept.
+	if intercept.Spec.Namespace != agent.Namespace || intercept.Spec.Agent != agent.Name || !state.AgentServesIntercept(agent, intercept) {
+		return &empty.Empty{}, nil
+	}
+	agentSessionID := rIReq.GetSession().GetSessionId()
+	if intercept = m.state.SetAgentFallback(ceptID, agentSessionID, rIReq.FallbackActive, rIReq.Message); intercept == nil {
+		return nil, status.Errorf(codes.NotFound, "Intercept with ID %q not found for this session", ceptID)
+	}
+
+	clientSessionID := intercept.ClientSession.GetSessionId()
+	e := audit.NewEntry(audit.ActionReviewIntercept, clientSessionID, m.state.GetClient(clientSessionID)).
+		WithIntercept(intercept.Id, intercept.Spec)
+	if rIReq.FallbackActive {
+		dlog.Infof(ctx, "Intercept %s falls back to the intercepted container in pod %s: %s", ceptID, agent.PodName, rIReq.Message)
+		e.Reason = "fallback active in pod " + agent.PodName
+		if rIReq.Message != "" {
+			e.Reason += ": " + rIReq.Message
+		}
+	} else {
+		dlog.Infof(ctx, "Intercept %s no longer falls back to the intercepted container in pod %s", ceptID, agent.PodName)
+		e.Reason = "fallback inactive in pod " + agent.PodName
+	}
+	m.audit.Record(ctx, e)
+	return &empty.Empty{}, nil
+}
+
 func (m *service) Tunnel(server rpc.Manager_TunnelServer) error {
 	ctx := server.Context()
 	stream, err := tunnel.NewServerStream(ctx, server)
 	if err != nil {
 		return status.Errorf(codes.FailedPrecondition, "failed to connect stream: %v", err)
 	}
-	return m.state.Tunnel(ctx, stream)
+	return m.state.Tunnel(ctx, stream, m.clock.Now)
 }
 
 func (m *service) WatchDial(session *rpc.SessionInfo, stream rpc.Manager_WatchDialServer) error {
@@ -1037,10 +1453,19 @@ func (m *service) WatchClusterInfo(session *rpc.SessionInfo, stream rpc.Manager_
 
 const agentSessionTTL = 15 * time.Second
 
-// expire removes stale sessions.
+// expire removes stale sessions and expired intercepts.
 func (m *service) expire(ctx context.Context) {
 	now := m.clock.Now()
-	m.state.ExpireSessions(ctx, now.Add(-managerutil.GetEnv(ctx).ClientConnectionTTL), now.Add(-agentSessionTTL))
+	expiredClients := m.state.ExpireSessions(ctx, now.Add(-managerutil.GetEnv(ctx).ClientConnectionTTL), now.Add(-agentSessionTTL))
+	for sessionID, client := range expiredClients {
+		m.audit.Record(ctx, audit.NewEntry(audit.ActionExpireSession, sessionID, client))
+	}
+	for _, ei := range m.state.ExpireIntercepts(ctx, now) {
+		sessionID := ei.Info.ClientSession.GetSessionId()
+		e := audit.NewEntry(audit.ActionExpireIntercept, sessionID, m.state.GetClient(sessionID)).WithIntercept(ei.Info.Id, ei.Info.Spec)
+		e.Reason = ei.Message
+		m.audit.Record(ctx, e)
+	}
 }
 
 // MaybeAddToken maybe adds apikey to the cluster so that the ambassador agent can login.
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
@@ -14,6 +15,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/test/bufconn"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	empty "google.golang.org/protobuf/types/known/emptypb"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -242,6 +244,83 @@ func TestConnect(t *testing.T) {
 	a.Equal(rpc.InterceptDispositionType_ACTIVE, hSnapI.Intercepts[0].Disposition)
 	t.Logf("=> agent[hello] intercept snapshot = %s", dumps(hSnapI))
 
+	// Hello's agent reports that the intercept falls back to the intercepted container
+
+	_, err = client.ReviewIntercept(ctx, &rpc.ReviewInterceptRequest{
+		Session:        helloSess,
+		Id:             hSnapI.Intercepts[0].Id,
+		Disposition:    rpc.InterceptDispositionType_ACTIVE,
+		Message:        "unreachable",
+		FallbackActive: true,
+		HealthReport:   true,
+	})
+	a.NoError(err)
+
+	aSnapI, err = aliceWI.Recv()
+	a.NoError(err)
+	a.Len(aSnapI.Intercepts, 1)
+	a.Equal(rpc.InterceptDispositionType_ACTIVE, aSnapI.Intercepts[0].Disposition)
+	a.True(aSnapI.Intercepts[0].FallbackActive)
+	a.Equal("unreachable", aSnapI.Intercepts[0].Message)
+
+	hSnapI, err = helloWI.Recv()
+	a.NoError(err)
+	a.True(hSnapI.Intercepts[0].FallbackActive)
+
+	// Alice pauses the intercept
+
+	paused, err := client.UpdateIntercept(ctx, &rpc.UpdateInterceptRequest{
+		Session:     aliceSess2,
+		Name:        spec.Name,
+		PauseAction: &rpc.UpdateInterceptRequest_Pause{Pause: true},
+	})
+	a.NoError(err)
+	a.Equal(rpc.InterceptDispositionType_PAUSED, paused.Disposition)
+
+	aSnapI, err = aliceWI.Recv()
+	a.NoError(err)
+	a.Len(aSnapI.Intercepts, 1)
+	a.Equal(rpc.InterceptDispositionType_PAUSED, aSnapI.Intercepts[0].Disposition)
+
+	hSnapI, err = helloWI.Recv()
+	a.NoError(err)
+	a.Len(hSnapI.Intercepts, 1)
+	a.Equal(rpc.InterceptDispositionType_PAUSED, hSnapI.Intercepts[0].Disposition)
+
+	// Pausing a paused intercept is a no-op
+
+	_, err = client.UpdateIntercept(ctx, &rpc.UpdateInterceptRequest{
+		Session:     aliceSess2,
+		Name:        spec.Name,
+		PauseAction: &rpc.UpdateInterceptRequest_Pause{Pause: true},
+	})
+	a.NoError(err)
+
+	// Alice resumes the intercept
+
+	resumed, err := client.UpdateIntercept(ctx, &rpc.UpdateInterceptRequest{
+		Session:     aliceSess2,
+		Name:        spec.Name,
+		PauseAction: &rpc.UpdateInterceptRequest_Resume{Resume: true},
+	})
+	a.NoError(err)
+	a.Equal(rpc.InterceptDispositionType_ACTIVE, resumed.Disposition)
+
+	aSnapI, err = aliceWI.Recv()
+	a.NoError(err)
+	a.Equal(rpc.InterceptDispositionType_ACTIVE, aSnapI.Intercepts[0].Disposition)
+
+	hSnapI, err = helloWI.Recv()
+	a.NoError(err)
+	a.Equal(rpc.InterceptDispositionType_ACTIVE, hSnapI.Intercepts[0].Disposition)
+
+	_, err = client.UpdateIntercept(ctx, &rpc.UpdateInterceptRequest{
+		Session:     aliceSess2,
+		Name:        spec.Name,
+		PauseAction: &rpc.UpdateInterceptRequest_Resume{Resume: true},
+	})
+	a.NoError(err)
+
 	// Creating a duplicate intercept yields an error
 
 	second, err := client.CreateIntercept(ctx, &rpc.CreateInterceptRequest{
@@ -252,13 +331,39 @@ func TestConnect(t *testing.T) {
 	a.Nil(second)
 	t.Logf("=> intercept info: %s", dumps(second))
 
-	// Alice removes the intercept
+	// Alice removes the intercept, once its connections have been drained
 
-	_, err = client.RemoveIntercept(ctx, &rpc.RemoveInterceptRequest2{
-		Session: aliceSess2,
-		Name:    spec.Name,
+	removed := make(chan error, 1)
+	go func() {
+		_, err := client.RemoveIntercept(ctx, &rpc.RemoveInterceptRequest2{
+			Session:      aliceSess2,
+			Name:         spec.Name,
+			DrainTimeout: durationpb.New(time.Minute),
+		})
+		removed <- err
+	}()
+
+	aSnapI, err = aliceWI.Recv()
+	a.NoError(err)
+	a.Len(aSnapI.Intercepts, 1)
+	a.Equal(rpc.InterceptDispositionType_DRAINING, aSnapI.Intercepts[0].Disposition)
+
+	hSnapI, err = helloWI.Recv()
+	a.NoError(err)
+	a.Len(hSnapI.Intercepts, 1)
+	a.Equal(rpc.InterceptDispositionType_DRAINING, hSnapI.Intercepts[0].Disposition)
+	a.Equal(time.Minute, hSnapI.Intercepts[0].DrainTimeout.AsDuration())
+
+	// Hello's agent reports that the connections have been drained
+
+	_, err = client.ReviewIntercept(ctx, &rpc.ReviewInterceptRequest{
+		Session:     helloSess,
+		Id:          hSnapI.Intercepts[0].Id,
+		Disposition: rpc.InterceptDispositionType_DRAINING,
+		Drained:     true,
 	})
 	a.NoError(err)
+	a.NoError(<-removed)
 	t.Logf("removed intercept")
 
 	aSnapI, err = aliceWI.Recv()
@@ -458,6 +563,62 @@ func TestRemoveIntercept_InterceptFinalizer(t *testing.T) {
 	})
 }
 
+func TestRemoveIntercept_drainOldAgent(t *testing.T) {
+	dlog.SetFallbackLogger(dlog.WrapTB(t, false))
+	ctx := dlog.NewTestContext(t, false)
+	a := assert.New(t)
+
+	testClients := testdata.GetTestClients(t)
+	testAgents := testdata.GetTestAgents(t)
+
+	prevVersion := version.Version
+	defer func() { version.Version = prevVersion }()
+	version.Version, version.Structured = version.Init("0.0.0-testing", "TELEPRESENCE_VERSION")
+
+	conn := getTestClientConn(ctx, t)
+	defer conn.Close()
+	client := rpc.NewManagerClient(conn)
+
+	// The agent predates the reports of drained intercepts.
+	hello := proto.Clone(testAgents["hello"]).(*rpc.AgentInfo)
+	hello.Version = "v2.12.1"
+
+	aliceSess, err := client.ArriveAsClient(ctx, testClients["alice"])
+	a.NoError(err)
+	helloSess, err := client.ArriveAsAgent(ctx, hello)
+	a.NoError(err)
+
+	first, err := client.CreateIntercept(ctx, &rpc.CreateInterceptRequest{
+		Session: aliceSess,
+		InterceptSpec: &rpc.InterceptSpec{
+			Name:       "first",
+			Namespace:  "default",
+			Client:     testClients["alice"].Name,
+			Agent:      testAgents["hello"].Name,
+			Mechanism:  "tcp",
+			TargetHost: "asdf",
+			TargetPort: 9876,
+		},
+	})
+	a.NoError(err)
+	_, err = client.ReviewIntercept(ctx, &rpc.ReviewInterceptRequest{
+		Session:     helloSess,
+		Id:          first.Id,
+		Disposition: rpc.InterceptDispositionType_ACTIVE,
+	})
+	a.NoError(err)
+
+	// There's no one to wait for.
+	start := time.Now()
+	_, err = client.RemoveIntercept(ctx, &rpc.RemoveInterceptRequest2{
+		Session:      aliceSess,
+		Name:         "first",
+		DrainTimeout: durationpb.New(time.Minute),
+	})
+	a.NoError(err)
+	a.Less(time.Since(start), 10*time.Second)
+}
+
 func TestUpdateIntercept(t *testing.T) {
 	var (
 		testClients = testdata.GetTestClients(t)
@@ -13,3 +13,9 @@ func agentHasMechanism(agent *rpc.AgentInfo, mechName string) bool {
 
 	return false
 }
+
+// isBuiltinMechanism returns true if the given mechanism is supported by the OSS traffic-agent,
+// i.e. when an intercept using it doesn't require the extended agent image.
+func isBuiltinMechanism(mechName string) bool {
+	return mechName == "tcp" || mechName == "http"
+}
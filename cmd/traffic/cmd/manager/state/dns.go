@@ -20,7 +20,9 @@ const RcodeNoAgents = 3841
 // AgentsLookupDNS will send the given request to all agents currently intercepted by the client identified with
 // the clientSessionID, it will then wait for results to arrive, collect those results, and return the result.
 func (s *State) AgentsLookupDNS(ctx context.Context, clientSessionID string, request *rpc.DNSRequest) (dnsproxy.RRs, int, error) {
+	start := time.Now()
 	rs := s.agentsLookup(ctx, clientSessionID, request)
+	s.metrics.observeDNSLookup(start)
 	if len(rs) == 0 {
 		return nil, RcodeNoAgents, nil
 	}
@@ -102,6 +104,9 @@ func (s *State) agentsLookup(ctx context.Context, clientSessionID string, reques
 			}
 			select {
 			case <-timout.Done():
+				if ctx.Err() == nil {
+					s.metrics.dnsLookupTimeouts.Inc()
+				}
 			case rs, ok := <-rsCh:
 				if ok {
 					rsBuf <- rs
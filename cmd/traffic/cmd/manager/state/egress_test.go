@@ -0,0 +1,36 @@
+package state
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	testdata "github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/internal/test"
+)
+
+func TestState_getEgressAgentSession(t *testing.T) {
+	ctx := context.Background()
+	testAgents := testdata.GetTestAgents(t)
+	now := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	s := NewState(ctx)
+	hasEgressAgent := func(workload, namespace string) bool {
+		return s.getEgressAgentSession(workload, namespace) != nil
+	}
+	assert.False(t, hasEgressAgent("demo", "default"))
+
+	d1 := s.AddAgent(testAgents["demo1"], now)
+	d2 := s.AddAgent(testAgents["demo2"], now)
+	s.AddAgent(testAgents["hello"], now)
+	assert.True(t, hasEgressAgent("demo", "default"))
+	assert.True(t, hasEgressAgent("hello", "default"))
+	assert.False(t, hasEgressAgent("demo", "other"))
+	assert.False(t, hasEgressAgent("does-not-exist", "default"))
+
+	s.RemoveSession(ctx, d1)
+	assert.True(t, hasEgressAgent("demo", "default"))
+	s.RemoveSession(ctx, d2)
+	assert.False(t, hasEgressAgent("demo", "default"))
+}
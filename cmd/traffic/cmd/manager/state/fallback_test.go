@@ -0,0 +1,60 @@
+package state_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+	testdata "github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/internal/test"
+	manager "github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/state"
+)
+
+func TestState_SetAgentFallback(t *testing.T) {
+	ctx := context.Background()
+	testAgents := testdata.GetTestAgents(t)
+	testClients := testdata.GetTestClients(t)
+
+	clock := &FakeClock{}
+	state := manager.NewState(ctx)
+
+	sessions := map[string]string{}
+	for _, pod := range []string{"hello-a", "hello-b"} {
+		a := proto.Clone(testAgents["hello"]).(*rpc.AgentInfo)
+		a.PodName = pod
+		sessions[pod] = state.AddAgent(a, clock.Now())
+	}
+	alice := state.AddClient(testClients["alice"], clock.Now())
+	cept, err := state.AddIntercept(alice, "", "", testClients["alice"], &rpc.InterceptSpec{
+		Name: "hello", Client: "alice", Agent: "hello", Namespace: "default", Mechanism: "tcp",
+	}, clock.Now())
+	require.NoError(t, err)
+	state.UpdateIntercept(cept.Id, func(cept *rpc.InterceptInfo) {
+		cept.Disposition = rpc.InterceptDispositionType_ACTIVE
+	})
+
+	// Each agent reports for itself.
+	cept = state.SetAgentFallback(cept.Id, sessions["hello-a"], true, "a is unreachable")
+	require.NotNil(t, cept)
+	assert.True(t, cept.FallbackActive)
+	assert.Equal(t, "a is unreachable", cept.Message)
+
+	cept = state.SetAgentFallback(cept.Id, sessions["hello-b"], true, "b is unreachable")
+	assert.True(t, cept.FallbackActive)
+
+	// One agent recovering doesn't clear the fallback of the other.
+	cept = state.SetAgentFallback(cept.Id, sessions["hello-a"], false, "")
+	assert.True(t, cept.FallbackActive)
+	assert.Equal(t, "b is unreachable", cept.Message)
+
+	// An agent that departs no longer falls back.
+	state.RemoveSession(ctx, sessions["hello-b"])
+	cept, ok := state.GetIntercept(cept.Id)
+	require.True(t, ok)
+	assert.False(t, cept.FallbackActive)
+
+	assert.Nil(t, state.SetAgentFallback("nope", sessions["hello-a"], true, ""))
+}
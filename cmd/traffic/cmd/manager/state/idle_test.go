@@ -0,0 +1,61 @@
+package state
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+	testdata "github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/internal/test"
+	"github.com/telepresenceio/telepresence/v2/pkg/ipproto"
+	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
+)
+
+func TestState_interceptActivity(t *testing.T) {
+	ctx := context.Background()
+	testAgents := testdata.GetTestAgents(t)
+	testClients := testdata.GetTestClients(t)
+	now := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	s := NewState(ctx)
+	s.AddAgent(testAgents["hello"], now)
+	alice := s.AddClient(testClients["alice"], now)
+	ii, err := s.AddIntercept(alice, "", "", testClients["alice"], &rpc.InterceptSpec{
+		Name: "hello", Client: "alice", Agent: "hello", Namespace: "default", Mechanism: "tcp",
+		IdleTimeout: durationpb.New(10 * time.Minute),
+	}, now)
+	require.NoError(t, err)
+	start := now
+	id := tunnel.NewConnID(ipproto.TCP, net.IP{10, 0, 0, 1}, net.IP{127, 0, 0, 1}, 34567, 8080)
+	agentEnd, peer := tunnel.NewPipe(id, alice)
+	stream := s.interceptActivity(agentEnd, testAgents["hello"], alice, clock)
+
+	isIdle := func(at time.Time) bool {
+		s.ExpireIntercepts(ctx, at)
+		_, ok := s.GetIntercept(ii.Id)
+		return !ok
+	}
+
+	// A message with payload, in either direction, is activity.
+	now = start.Add(5 * time.Minute)
+	require.NoError(t, peer.Send(ctx, tunnel.NewMessage(tunnel.Normal, []byte("GET / HTTP/1.1\r\n\r\n"))))
+	_, err = stream.Receive(ctx)
+	require.NoError(t, err)
+	assert.False(t, isIdle(start.Add(14*time.Minute)))
+
+	now = start.Add(20 * time.Minute)
+	require.NoError(t, stream.Send(ctx, tunnel.NewMessage(tunnel.Normal, []byte("HTTP/1.1 200 OK\r\n\r\n"))))
+	_, err = peer.Receive(ctx)
+	require.NoError(t, err)
+	assert.False(t, isIdle(start.Add(29*time.Minute)))
+
+	// A stream that stays open without routing traffic, such as a pooled HTTP connection, doesn't keep
+	// the intercept alive.
+	assert.True(t, isIdle(start.Add(30*time.Minute)))
+}
@@ -3,6 +3,7 @@ package state
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -31,6 +32,7 @@ import (
 	"github.com/telepresenceio/telepresence/v2/pkg/install"
 	"github.com/telepresenceio/telepresence/v2/pkg/tracing"
 	"github.com/telepresenceio/telepresence/v2/pkg/version"
+	"github.com/telepresenceio/telepresence/v2/pkg/workload"
 )
 
 // PrepareIntercept ensures that the given request can be matched against the intercept configuration of
@@ -74,7 +76,7 @@ func (s *State) PrepareIntercept(ctx context.Context, cr *managerrpc.CreateInter
 		return interceptError(err)
 	}
 
-	ac, err := s.getOrCreateAgentConfig(ctx, wl, spec.Mechanism != "tcp")
+	ac, err := s.getOrCreateAgentConfig(ctx, wl, !isBuiltinMechanism(spec.Mechanism))
 	if err != nil {
 		return interceptError(err)
 	}
@@ -82,6 +84,13 @@ func (s *State) PrepareIntercept(ctx context.Context, cr *managerrpc.CreateInter
 	if err != nil {
 		return interceptError(err)
 	}
+	if pod, ok := workload.PodImpl(wl); ok && agentconfig.FindAgentContainer(&pod.Spec) == nil {
+		// A bare pod isn't restarted, because nothing recreates it if that fails.
+		return interceptError(errcat.User.Newf(
+			"pod %s.%s isn't controlled by a workload, so it can't be restarted with a traffic-agent. "+
+				"Recreate the pod, e.g. using \"kubectl replace --force\", and then intercept it again",
+			pod.Name, pod.Namespace))
+	}
 	if err = s.waitForAgent(ctx, ac.AgentName, ac.Namespace, failedCreateCh); err != nil {
 		return interceptError(err)
 	}
@@ -532,19 +541,90 @@ type InterceptFinalizer func(ctx context.Context, interceptInfo *managerrpc.Inte
 
 type interceptState struct {
 	sync.Mutex
-	lastInfoCh  chan *managerrpc.InterceptInfo
-	finalizers  []InterceptFinalizer
-	interceptID string
+	lastInfoCh   chan *managerrpc.InterceptInfo
+	finalizers   []InterceptFinalizer
+	interceptID  string
+	lastActivity time.Time
+
+	// fallbacks are the reasons why agents send the intercepted traffic to the intercepted container,
+	// keyed by the session IDs of the agents.
+	fallbacks map[string]string
 }
 
-func newInterceptState(interceptID string) *interceptState {
+func newInterceptState(interceptID string, now time.Time) *interceptState {
 	is := &interceptState{
-		lastInfoCh:  make(chan *managerrpc.InterceptInfo),
-		interceptID: interceptID,
+		lastInfoCh:   make(chan *managerrpc.InterceptInfo),
+		interceptID:  interceptID,
+		lastActivity: now,
 	}
 	return is
 }
 
+// recordActivity records that intercepted traffic was routed to or from the client at the given time.
+func (is *interceptState) recordActivity(now time.Time) {
+	is.Lock()
+	if now.After(is.lastActivity) {
+		is.lastActivity = now
+	}
+	is.Unlock()
+}
+
+// expiry returns the reason, "ttl" or "idle", for why the given intercept has expired at the given time
+// together with a human-friendly explanation, or two empty strings if the intercept hasn't expired.
+func (is *interceptState) expiry(ii *managerrpc.InterceptInfo, now time.Time) (reason, msg string) {
+	if ea := ii.ExpiresAt; ea != nil && !now.Before(ea.AsTime()) {
+		return "ttl", fmt.Sprintf("its time-to-live of %s has passed", ii.Spec.Ttl.AsDuration())
+	}
+	idleTimeout := ii.Spec.IdleTimeout.AsDuration()
+	if idleTimeout <= 0 {
+		return "", ""
+	}
+	is.Lock()
+	defer is.Unlock()
+	if now.Sub(is.lastActivity) >= idleTimeout {
+		return "idle", fmt.Sprintf("it has been idle for more than %s", idleTimeout)
+	}
+	return "", ""
+}
+
+// setFallback records whether the agent with the given session ID falls back to the intercepted container,
+// and returns true if that changed.
+func (is *interceptState) setFallback(agentSessionID string, active bool, reason string) bool {
+	is.Lock()
+	defer is.Unlock()
+	if !active {
+		if _, ok := is.fallbacks[agentSessionID]; !ok {
+			return false
+		}
+		delete(is.fallbacks, agentSessionID)
+		return true
+	}
+	if is.fallbacks == nil {
+		is.fallbacks = make(map[string]string)
+	}
+	if r, ok := is.fallbacks[agentSessionID]; ok && r == reason {
+		return false
+	}
+	is.fallbacks[agentSessionID] = reason
+	return true
+}
+
+// fallback returns true if any agent falls back to the intercepted container, together with the reason
+// reported by one of them.
+func (is *interceptState) fallback() (active bool, reason string) {
+	is.Lock()
+	defer is.Unlock()
+	if len(is.fallbacks) == 0 {
+		return false, ""
+	}
+	ids := make([]string, 0, len(is.fallbacks))
+	for id := range is.fallbacks {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return true, is.fallbacks[ids[0]]
+}
+
 func (is *interceptState) addFinalizer(finalizer InterceptFinalizer) {
 	is.Lock()
 	defer is.Unlock()
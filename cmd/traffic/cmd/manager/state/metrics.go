@@ -0,0 +1,184 @@
+package state
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/pkg/ipproto"
+	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
+)
+
+const metricsNamespace = "traffic_manager"
+
+// metrics are the Prometheus collectors that are updated by the State. They are created with the
+// State but not exposed until they are registered using RegisterMetrics.
+type metrics struct {
+	interceptsCreated prometheus.Counter
+	interceptsRemoved prometheus.Counter
+	interceptsFailed  *prometheus.CounterVec
+	interceptsExpired *prometheus.CounterVec
+	tunnelBytes       *prometheus.CounterVec
+	tunnelStreams     *prometheus.GaugeVec
+	dnsLookupDuration prometheus.Histogram
+	dnsLookupTimeouts prometheus.Counter
+	sessionsExpired   *prometheus.CounterVec
+	gauges            []prometheus.Collector
+}
+
+func newMetrics(s *State) *metrics {
+	return &metrics{
+		interceptsCreated: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "intercepts_created_total",
+			Help:      "Number of intercepts that have been created",
+		}),
+		interceptsRemoved: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "intercepts_removed_total",
+			Help:      "Number of intercepts that have been removed",
+		}),
+		interceptsFailed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "intercepts_failed_total",
+			Help:      "Number of intercepts that could not be created or that entered an error state, by reason",
+		}, []string{"reason"}),
+		interceptsExpired: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "intercepts_expired_total",
+			Help:      "Number of intercepts that were removed because their time-to-live passed or because they were idle, by reason",
+		}, []string{"reason"}),
+		tunnelBytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "tunnel_bytes_total",
+			Help:      "Number of payload bytes received from client and agent tunnels, by protocol",
+		}, []string{"protocol"}),
+		tunnelStreams: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "tunnel_streams",
+			Help:      "Number of open client and agent tunnel streams, by protocol",
+		}, []string{"protocol"}),
+		dnsLookupDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "agents_dns_lookup_duration_seconds",
+			Help:      "Time spent waiting for traffic-agents to respond to DNS lookups",
+			Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5},
+		}),
+		dnsLookupTimeouts: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "agents_dns_lookup_timeouts_total",
+			Help:      "Number of DNS lookups sent to traffic-agents that timed out",
+		}),
+		sessionsExpired: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "sessions_expired_total",
+			Help:      "Number of sessions that were removed because they expired, by session type",
+		}, []string{"type"}),
+		gauges: []prometheus.Collector{
+			prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+				Namespace:   metricsNamespace,
+				Name:        "sessions",
+				Help:        "Number of active sessions, by session type",
+				ConstLabels: prometheus.Labels{"type": "client"},
+			}, func() float64 {
+				return float64(s.clients.CountAll())
+			}),
+			prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+				Namespace:   metricsNamespace,
+				Name:        "sessions",
+				Help:        "Number of active sessions, by session type",
+				ConstLabels: prometheus.Labels{"type": "agent"},
+			}, func() float64 {
+				return float64(s.agents.CountAll())
+			}),
+			prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+				Namespace: metricsNamespace,
+				Name:      "intercepts",
+				Help:      "Number of current intercepts",
+			}, func() float64 {
+				return float64(s.intercepts.CountAll())
+			}),
+		},
+	}
+}
+
+func (m *metrics) collectors() []prometheus.Collector {
+	return append([]prometheus.Collector{
+		m.interceptsCreated,
+		m.interceptsRemoved,
+		m.interceptsFailed,
+		m.interceptsExpired,
+		m.tunnelBytes,
+		m.tunnelStreams,
+		m.dnsLookupDuration,
+		m.dnsLookupTimeouts,
+		m.sessionsExpired,
+	}, m.gauges...)
+}
+
+// RegisterMetrics registers the Prometheus metrics of this State with the given registerer.
+func (s *State) RegisterMetrics(reg prometheus.Registerer) error {
+	for _, c := range s.metrics.collectors() {
+		if err := reg.Register(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reasonAlreadyExists is the reason used when an intercept can't be created because the client
+// already has an intercept with the same name.
+const reasonAlreadyExists = "ALREADY_EXISTS"
+
+// interceptFailed counts an intercept that entered the given error disposition.
+func (m *metrics) interceptFailed(disposition rpc.InterceptDispositionType) {
+	m.interceptFailedWithReason(disposition.String())
+}
+
+// interceptFailedWithReason counts an intercept that failed for a reason that isn't a disposition.
+func (m *metrics) interceptFailedWithReason(reason string) {
+	m.interceptsFailed.WithLabelValues(reason).Inc()
+}
+
+// isErrorDisposition returns true if the given disposition denotes an intercept that failed.
+func isErrorDisposition(disposition rpc.InterceptDispositionType) bool {
+	switch disposition {
+	case rpc.InterceptDispositionType_UNSPECIFIED,
+		rpc.InterceptDispositionType_ACTIVE,
+		rpc.InterceptDispositionType_WAITING,
+		rpc.InterceptDispositionType_PAUSED,
+		rpc.InterceptDispositionType_DRAINING:
+		return false
+	default:
+		return true
+	}
+}
+
+func (m *metrics) observeDNSLookup(start time.Time) {
+	m.dnsLookupDuration.Observe(time.Since(start).Seconds())
+}
+
+// meteredStream is a tunnel.Stream that counts the payload bytes that it receives.
+type meteredStream struct {
+	tunnel.Stream
+	bytes prometheus.Counter
+}
+
+// meterStream returns a stream that counts the bytes received from the given stream, along with
+// a function that must be called when the stream is no longer in use.
+func (m *metrics) meterStream(stream tunnel.Stream) (tunnel.Stream, func()) {
+	proto := ipproto.String(stream.ID().Protocol())
+	streams := m.tunnelStreams.WithLabelValues(proto)
+	streams.Inc()
+	return &meteredStream{Stream: stream, bytes: m.tunnelBytes.WithLabelValues(proto)}, streams.Dec
+}
+
+func (s *meteredStream) Receive(ctx context.Context) (tunnel.Message, error) {
+	m, err := s.Stream.Receive(ctx)
+	if err == nil && m.Code() == tunnel.Normal {
+		s.bytes.Add(float64(len(m.Payload())))
+	}
+	return m, err
+}
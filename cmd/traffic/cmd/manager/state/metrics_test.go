@@ -0,0 +1,96 @@
+package state_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+	testdata "github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/internal/test"
+	manager "github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/state"
+)
+
+// metricValue returns the value of the metric with the given name and labels.
+func metricValue(t *testing.T, reg prometheus.Gatherer, name string, labels map[string]string) float64 {
+	t.Helper()
+	mfs, err := reg.Gather()
+	require.NoError(t, err)
+	for _, mf := range mfs {
+		if mf.GetName() != name {
+			continue
+		}
+	nextMetric:
+		for _, m := range mf.Metric {
+			for _, lp := range m.Label {
+				if labels[lp.GetName()] != lp.GetValue() {
+					continue nextMetric
+				}
+			}
+			switch mf.GetType() {
+			case dto.MetricType_COUNTER:
+				return m.Counter.GetValue()
+			case dto.MetricType_GAUGE:
+				return m.Gauge.GetValue()
+			}
+		}
+	}
+	return 0
+}
+
+func TestState_Metrics(t *testing.T) {
+	ctx := context.Background()
+	testAgents := testdata.GetTestAgents(t)
+	testClients := testdata.GetTestClients(t)
+
+	clock := &FakeClock{}
+	state := manager.NewState(ctx)
+	reg := prometheus.NewPedanticRegistry()
+	require.NoError(t, state.RegisterMetrics(reg))
+
+	state.AddAgent(testAgents["hello"], clock.Now())
+	alice := state.AddClient(testClients["alice"], clock.Now())
+	bob := state.AddClient(testClients["bob"], clock.Now())
+	assert.Equal(t, 2.0, metricValue(t, reg, "traffic_manager_sessions", map[string]string{"type": "client"}))
+	assert.Equal(t, 1.0, metricValue(t, reg, "traffic_manager_sessions", map[string]string{"type": "agent"}))
+
+	_, err := state.AddIntercept(alice, "", "", testClients["alice"], &rpc.InterceptSpec{
+		Name: "hello", Client: "alice", Agent: "hello", Namespace: "default", Mechanism: "tcp",
+	}, clock.Now())
+	require.NoError(t, err)
+	_, err = state.AddIntercept(bob, "", "", testClients["bob"], &rpc.InterceptSpec{
+		Name: "missing", Client: "bob", Agent: "missing", Namespace: "default", Mechanism: "tcp",
+	}, clock.Now())
+	require.NoError(t, err)
+	_, err = state.AddIntercept("unknown", "", "", testClients["bob"], &rpc.InterceptSpec{
+		Name: "hello", Client: "bob", Agent: "hello", Namespace: "default", Mechanism: "tcp",
+	}, clock.Now())
+	require.Error(t, err)
+	_, err = state.AddIntercept(alice, "", "", testClients["alice"], &rpc.InterceptSpec{
+		Name: "hello", Client: "alice", Agent: "hello", Namespace: "default", Mechanism: "tcp",
+	}, clock.Now())
+	require.Error(t, err)
+
+	assert.Equal(t, 2.0, metricValue(t, reg, "traffic_manager_intercepts_created_total", nil))
+	assert.Equal(t, 2.0, metricValue(t, reg, "traffic_manager_intercepts", nil))
+	assert.Equal(t, 1.0, metricValue(t, reg, "traffic_manager_intercepts_failed_total", map[string]string{"reason": "NO_AGENT"}))
+	assert.Equal(t, 1.0, metricValue(t, reg, "traffic_manager_intercepts_failed_total", map[string]string{"reason": "NO_CLIENT"}))
+	assert.Equal(t, 1.0, metricValue(t, reg, "traffic_manager_intercepts_failed_total", map[string]string{"reason": "ALREADY_EXISTS"}))
+	assert.Equal(t, 0.0, metricValue(t, reg, "traffic_manager_intercepts_failed_total", map[string]string{"reason": "BAD_ARGS"}))
+
+	require.True(t, state.RemoveIntercept(alice+":hello"))
+	assert.Equal(t, 1.0, metricValue(t, reg, "traffic_manager_intercepts_removed_total", nil))
+
+	// Bob's session expires, and with it, the intercept that it created.
+	clock.When = 10
+	state.MarkSession(&rpc.RemainRequest{Session: &rpc.SessionInfo{SessionId: alice}}, clock.Now())
+	state.ExpireSessions(ctx, clock.Now().Add(-5*time.Second), clock.Now().Add(-time.Hour))
+	assert.Equal(t, 1.0, metricValue(t, reg, "traffic_manager_sessions_expired_total", map[string]string{"type": "client"}))
+	assert.Equal(t, 1.0, metricValue(t, reg, "traffic_manager_sessions", map[string]string{"type": "client"}))
+	assert.Equal(t, 2.0, metricValue(t, reg, "traffic_manager_intercepts_removed_total", nil))
+	assert.Equal(t, 0.0, metricValue(t, reg, "traffic_manager_intercepts", nil))
+}
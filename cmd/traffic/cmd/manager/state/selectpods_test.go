@@ -0,0 +1,82 @@
+package state_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+	testdata "github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/internal/test"
+	manager "github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/state"
+)
+
+func TestState_SelectPods(t *testing.T) {
+	ctx := context.Background()
+	testAgents := testdata.GetTestAgents(t)
+	testClients := testdata.GetTestClients(t)
+
+	clock := &FakeClock{}
+	state := manager.NewState(ctx)
+
+	replica := func(podName string) *rpc.AgentInfo {
+		a := proto.Clone(testAgents["hello"]).(*rpc.AgentInfo)
+		a.PodName = podName
+		a.PodIp = "10.0.0." + podName[len(podName)-1:]
+		return a
+	}
+	agents := map[string]*rpc.AgentInfo{}
+	sessions := map[string]string{}
+	for _, pod := range []string{"hello-c", "hello-a", "hello-b"} {
+		agents[pod] = replica(pod)
+		sessions[pod] = state.AddAgent(agents[pod], clock.Now())
+	}
+	alice := state.AddClient(testClients["alice"], clock.Now())
+
+	t.Run("pod", func(t *testing.T) {
+		cept, err := state.AddIntercept(alice, "", "", testClients["alice"], &rpc.InterceptSpec{
+			Name: "hello-pod", Client: "alice", Agent: "hello", Namespace: "default", Mechanism: "tcp", Pod: "hello-b",
+		}, clock.Now())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"hello-b"}, cept.SelectedPods)
+		assert.True(t, manager.AgentServesIntercept(agents["hello-b"], cept))
+		assert.False(t, manager.AgentServesIntercept(agents["hello-a"], cept))
+		require.True(t, state.RemoveIntercept(cept.Id))
+	})
+
+	t.Run("missing pod", func(t *testing.T) {
+		cept, err := state.AddIntercept(alice, "", "", testClients["alice"], &rpc.InterceptSpec{
+			Name: "hello-missing", Client: "alice", Agent: "hello", Namespace: "default", Mechanism: "tcp", Pod: "hello-x",
+		}, clock.Now())
+		require.NoError(t, err)
+		assert.Equal(t, rpc.InterceptDispositionType_NO_AGENT, cept.Disposition)
+		require.True(t, state.RemoveIntercept(cept.Id))
+	})
+
+	t.Run("replicas", func(t *testing.T) {
+		cept, err := state.AddIntercept(alice, "", "", testClients["alice"], &rpc.InterceptSpec{
+			Name: "hello-replicas", Client: "alice", Agent: "hello", Namespace: "default", Mechanism: "tcp", Replicas: 2,
+		}, clock.Now())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"hello-a", "hello-b"}, cept.SelectedPods)
+		assert.False(t, manager.AgentServesIntercept(agents["hello-c"], cept))
+
+		// The selection moves to the remaining replica when a selected pod goes away.
+		state.RemoveSession(ctx, sessions["hello-a"])
+		cept, ok := state.GetIntercept(cept.Id)
+		require.True(t, ok)
+		assert.Equal(t, []string{"hello-b", "hello-c"}, cept.SelectedPods)
+		require.True(t, state.RemoveIntercept(cept.Id))
+	})
+
+	t.Run("all", func(t *testing.T) {
+		cept, err := state.AddIntercept(alice, "", "", testClients["alice"], &rpc.InterceptSpec{
+			Name: "hello-all", Client: "alice", Agent: "hello", Namespace: "default", Mechanism: "tcp",
+		}, clock.Now())
+		require.NoError(t, err)
+		assert.Empty(t, cept.SelectedPods)
+		assert.True(t, manager.AgentServesIntercept(agents["hello-c"], cept))
+	})
+}
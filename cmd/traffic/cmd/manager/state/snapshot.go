@@ -0,0 +1,68 @@
+package state
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/datawire/dlib/dlog"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+)
+
+// Snapshot is the part of the State that survives a restart of the traffic-manager. Agent sessions
+// are not included, because the agents arrive again when the traffic-manager restarts.
+type Snapshot struct {
+	// Clients are the client sessions, keyed by session ID.
+	Clients map[string]*rpc.ClientInfo
+
+	// Intercepts are the intercepts of the client sessions, keyed by intercept ID.
+	Intercepts map[string]*rpc.InterceptInfo
+}
+
+// Snapshot returns the client sessions and intercepts of the state.
+func (s *State) Snapshot() *Snapshot {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return &Snapshot{
+		Clients:    s.clients.LoadAll(),
+		Intercepts: s.intercepts.LoadAll(),
+	}
+}
+
+// Restore adds the client sessions and intercepts of the given snapshot to the state. The sessions
+// are considered marked at the given time, so they expire unless their clients continue to use them,
+// or arrive again and re-attach to them. The restored intercepts wait for their agents to arrive again.
+func (s *State) Restore(ctx context.Context, snap *Snapshot, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for sessionID, client := range snap.Clients {
+		if _, ok := s.sessions[sessionID]; ok {
+			continue
+		}
+		s.clients.Store(sessionID, client)
+		s.sessions[sessionID] = newClientSessionState(s.ctx, now)
+		s.restored[sessionID] = struct{}{}
+	}
+	for interceptID, cept := range snap.Intercepts {
+		if _, ok := s.clients.Load(cept.ClientSession.GetSessionId()); !ok {
+			dlog.Debugf(ctx, "Intercept %s is not restored because its client session is gone", interceptID)
+			continue
+		}
+		switch cept.Disposition {
+		case rpc.InterceptDispositionType_DRAINING:
+			// The intercept was being removed.
+			continue
+		case rpc.InterceptDispositionType_PAUSED:
+		default:
+			cept = proto.Clone(cept).(*rpc.InterceptInfo)
+			cept.Disposition = rpc.InterceptDispositionType_WAITING
+			cept.Message = "Waiting for Agent approval"
+			cept.FallbackActive = false
+		}
+		if _, loaded := s.intercepts.LoadOrStore(interceptID, cept); !loaded {
+			s.interceptStates[interceptID] = newInterceptState(interceptID, now)
+		}
+	}
+	dlog.Infof(ctx, "Restored %d client sessions and %d intercepts", s.clients.CountAll(), s.intercepts.CountAll())
+}
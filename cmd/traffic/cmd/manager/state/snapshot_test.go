@@ -0,0 +1,107 @@
+package state_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+	testdata "github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/internal/test"
+	manager "github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/state"
+)
+
+func TestState_SnapshotRestore(t *testing.T) {
+	ctx := context.Background()
+	testAgents := testdata.GetTestAgents(t)
+	testClients := testdata.GetTestClients(t)
+
+	clock := &FakeClock{}
+	state := manager.NewState(ctx)
+	state.AddAgent(testAgents["hello"], clock.Now())
+	alice := state.AddClient(testClients["alice"], clock.Now())
+	addIntercept := func(name string, disposition rpc.InterceptDispositionType) string {
+		cept, err := state.AddIntercept(alice, "", "", testClients["alice"], &rpc.InterceptSpec{
+			Name: name, Client: "alice", Agent: "hello", Namespace: "default", Mechanism: "tcp",
+		}, clock.Now())
+		require.NoError(t, err)
+		state.UpdateIntercept(cept.Id, func(cept *rpc.InterceptInfo) {
+			cept.Disposition = disposition
+		})
+		return cept.Id
+	}
+	active := addIntercept("active", rpc.InterceptDispositionType_ACTIVE)
+	paused := addIntercept("paused", rpc.InterceptDispositionType_PAUSED)
+	draining := addIntercept("draining", rpc.InterceptDispositionType_DRAINING)
+
+	// An intercept of a session that isn't in the snapshot.
+	snap := state.Snapshot()
+	snap.Intercepts["gone:hello"] = &rpc.InterceptInfo{
+		Id:            "gone:hello",
+		Spec:          &rpc.InterceptSpec{Name: "hello", Agent: "hello", Namespace: "default"},
+		ClientSession: &rpc.SessionInfo{SessionId: "gone"},
+	}
+
+	restored := manager.NewState(ctx)
+	restored.Restore(ctx, snap, clock.Now())
+
+	assert.Equal(t, testClients["alice"].Name, restored.GetClient(alice).GetName())
+	cept, ok := restored.GetIntercept(active)
+	require.True(t, ok)
+	assert.Equal(t, rpc.InterceptDispositionType_WAITING, cept.Disposition)
+	cept, ok = restored.GetIntercept(paused)
+	require.True(t, ok)
+	assert.Equal(t, rpc.InterceptDispositionType_PAUSED, cept.Disposition)
+	_, ok = restored.GetIntercept(draining)
+	assert.False(t, ok)
+	_, ok = restored.GetIntercept("gone:hello")
+	assert.False(t, ok)
+
+	// The client continues to use its session.
+	assert.True(t, restored.MarkSession(&rpc.RemainRequest{Session: &rpc.SessionInfo{SessionId: alice}}, clock.Now()))
+
+	// The session expires when the client doesn't return.
+	clock.When = 120
+	restored.ExpireSessions(ctx, clock.Now().Add(-time.Minute), clock.Now().Add(-time.Minute))
+	assert.Nil(t, restored.GetClient(alice))
+	_, ok = restored.GetIntercept(active)
+	assert.False(t, ok)
+}
+
+func TestState_RestoreReattach(t *testing.T) {
+	ctx := context.Background()
+	testAgents := testdata.GetTestAgents(t)
+	testClients := testdata.GetTestClients(t)
+
+	clock := &FakeClock{}
+	state := manager.NewState(ctx)
+	state.AddAgent(testAgents["hello"], clock.Now())
+	alice := state.AddClient(testClients["alice"], clock.Now())
+	cept, err := state.AddIntercept(alice, "", "", testClients["alice"], &rpc.InterceptSpec{
+		Name: "hello", Client: "alice", Agent: "hello", Namespace: "default", Mechanism: "tcp",
+	}, clock.Now())
+	require.NoError(t, err)
+
+	restored := manager.NewState(ctx)
+	restored.Restore(ctx, state.Snapshot(), clock.Now())
+
+	// Another client doesn't get alice's session.
+	bob := restored.AddClient(testClients["bob"], clock.Now())
+	assert.NotEqual(t, alice, bob)
+
+	// Alice arrives again and re-attaches to the restored session and its intercept.
+	assert.Equal(t, alice, restored.AddClient(testClients["alice"], clock.Now()))
+	cept, ok := restored.GetIntercept(cept.Id)
+	require.True(t, ok)
+	assert.Equal(t, alice, cept.ClientSession.SessionId)
+
+	// The session is re-attached only once.
+	assert.NotEqual(t, alice, restored.AddClient(testClients["alice"], clock.Now()))
+
+	// The session doesn't expire, because it was marked when alice re-attached.
+	clock.When = 30
+	restored.ExpireSessions(ctx, clock.Now().Add(-time.Minute), clock.Now().Add(-time.Minute))
+	assert.NotNil(t, restored.GetClient(alice))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sort"
 	"sync"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/datawire/dlib/dlog"
 	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
@@ -44,28 +46,37 @@ type State struct {
 	//  7. `cfgMapLocks` access must be concurrency protected
 	//  8. `cachedAgentImage` access must be concurrency protected
 	//  9. `interceptState` must be concurrency protected and updated/deleted in sync with intercepts
+	// 10. `agentRemovals` needs to stay in-sync with the `IdleRemoval` of the `agents`
+	// 11. `restored` needs to stay in-sync with `clients`
 	intercepts      watchable.Map[*rpc.InterceptInfo]    // info for intercepts, keyed by intercept id
 	agents          watchable.Map[*rpc.AgentInfo]        // info for agent sessions, keyed by session id
 	clients         watchable.Map[*rpc.ClientInfo]       // info for client sessions, keyed by session id
 	sessions        map[string]SessionState              // info for all sessions, keyed by session id
 	agentsByName    map[string]map[string]*rpc.AgentInfo // indexed copy of `agents`
 	interceptStates map[string]*interceptState
+	agentRemovals   map[string]time.Time // idle removal times of agents, keyed by "name.namespace"
+	restored        map[string]struct{}  // IDs of restored client sessions that their clients haven't used yet
 	timedLogLevel   log.TimedLevel
 	llSubs          *loglevelSubscribers
 	cfgMapLocks     map[string]*sync.Mutex
+	metrics         *metrics
 }
 
 func NewState(ctx context.Context) *State {
 	loglevel := os.Getenv("LOG_LEVEL")
-	return &State{
+	s := &State{
 		ctx:             ctx,
 		sessions:        make(map[string]SessionState),
 		agentsByName:    make(map[string]map[string]*rpc.AgentInfo),
 		cfgMapLocks:     make(map[string]*sync.Mutex),
 		interceptStates: make(map[string]*interceptState),
+		agentRemovals:   make(map[string]time.Time),
+		restored:        make(map[string]struct{}),
 		timedLogLevel:   log.NewTimedLevel(loglevel, log.SetLevel),
 		llSubs:          newLoglevelSubscribers(),
 	}
+	s.metrics = newMetrics(s)
+	return s
 }
 
 // Internal ////////////////////////////////////////////////////////////////////////////////////////
@@ -84,6 +95,10 @@ func (s *State) unlockedCheckAgentsForIntercept(intercept *rpc.InterceptInfo) (e
 		// Continue through; we can trasition to an error state from here.
 	case rpc.InterceptDispositionType_WAITING:
 		// Continue through; we can trasition to an error state from here.
+	case rpc.InterceptDispositionType_PAUSED:
+		// Continue through; we can trasition to an error state from here.
+	case rpc.InterceptDispositionType_DRAINING:
+		// Continue through; we can trasition to an error state from here.
 	// error states ////////////////////////////////////////////////////////
 	case rpc.InterceptDispositionType_NO_CLIENT:
 		// Don't overwrite this error state.
@@ -119,6 +134,28 @@ func (s *State) unlockedCheckAgentsForIntercept(intercept *rpc.InterceptInfo) (e
 		return
 	}
 
+	if pod := intercept.Spec.Pod; pod != "" {
+		found := false
+		for _, agent := range agentList {
+			if agent.PodName == pod {
+				found = true
+				break
+			}
+		}
+		if !found {
+			errCode = rpc.InterceptDispositionType_NO_AGENT
+			errMsg = fmt.Sprintf("No agent found for pod %q of %q", pod, intercept.Spec.Agent)
+			return
+		}
+	}
+
+	// An agent that doesn't report its pod cannot be selected.
+	if intercept.Spec.Replicas > 0 && agentList[0].PodName == "" {
+		errCode = rpc.InterceptDispositionType_NO_AGENT
+		errMsg = fmt.Sprintf("Agents for %q are unable to limit an intercept to a number of replicas", intercept.Spec.Agent)
+		return
+	}
+
 	if !managerutil.AgentsAreCompatible(agentList) {
 		errCode = rpc.InterceptDispositionType_NO_AGENT
 		errMsg = fmt.Sprintf("Agents for %q are not consistent", intercept.Spec.Agent)
@@ -131,6 +168,13 @@ func (s *State) unlockedCheckAgentsForIntercept(intercept *rpc.InterceptInfo) (e
 		return
 	}
 
+	// An agent that doesn't know about mirroring would divert the traffic, so it must not get the intercept.
+	if intercept.Spec.Mirror && !agentHasMechanism(agentList[0], "mirror") {
+		errCode = rpc.InterceptDispositionType_NO_MECHANISM
+		errMsg = fmt.Sprintf("Agents for %q are unable to mirror traffic", intercept.Spec.Agent)
+		return
+	}
+
 	return rpc.InterceptDispositionType_UNSPECIFIED, ""
 }
 
@@ -145,6 +189,7 @@ func (s *State) MarkSession(req *rpc.RemainRequest, now time.Time) (ok bool) {
 
 	if sess, ok := s.sessions[sessionID]; ok {
 		sess.SetLastMarked(now)
+		delete(s.restored, sessionID)
 		if req.ApiKey != "" {
 			if client, ok := s.clients.Load(sessionID); ok {
 				client.ApiKey = req.ApiKey
@@ -174,6 +219,11 @@ func (s *State) gcSessionIntercepts(sessionID string) {
 	//  2. Don't have any agents (agent.Name == intercept.Spec.Agent)
 	// Alternatively, if the intercept is still live but has been switched over to a different agent, send it back to WAITING state
 	for interceptID, intercept := range s.intercepts.LoadAll() {
+		if is, ok := s.interceptStates[interceptID]; ok && isAgent && is.setFallback(sessionID, false, "") {
+			// The agent no longer falls back. The remaining agents report for themselves.
+			intercept.FallbackActive, intercept.Message = is.fallback()
+			s.intercepts.Store(interceptID, intercept)
+		}
 		if intercept.ClientSession.SessionId == sessionID {
 			// Client went away:
 			// Delete it.
@@ -181,6 +231,9 @@ func (s *State) gcSessionIntercepts(sessionID string) {
 		} else if errCode, errMsg := s.unlockedCheckAgentsForIntercept(intercept); errCode != 0 {
 			// Refcount went to zero:
 			// Tell the client, so that the client can tell us to delete it.
+			if intercept.Disposition != errCode {
+				s.metrics.interceptFailed(errCode)
+			}
 			intercept.Disposition = errCode
 			intercept.Message = errMsg
 			s.intercepts.Store(interceptID, intercept)
@@ -188,6 +241,9 @@ func (s *State) gcSessionIntercepts(sessionID string) {
 			// The agent whose podIP was stored by the intercept is dead, but it's not the last agent
 			// Send it back to waiting so that one of the other agents can pick it up and set their own podIP
 			intercept.Disposition = rpc.InterceptDispositionType_WAITING
+			s.unlockedSelectPods(intercept, sessionID)
+			s.intercepts.Store(interceptID, intercept)
+		} else if isAgent && s.unlockedSelectPods(intercept, sessionID) {
 			s.intercepts.Store(interceptID, intercept)
 		}
 	}
@@ -212,6 +268,7 @@ func (s *State) unlockedRemoveSession(sessionID string) {
 			s.agents.Delete(sessionID)
 		} else {
 			s.clients.Delete(sessionID)
+			delete(s.restored, sessionID)
 		}
 
 		delete(s.sessions, sessionID)
@@ -219,24 +276,34 @@ func (s *State) unlockedRemoveSession(sessionID string) {
 }
 
 // ExpireSessions prunes any sessions that haven't had a MarkSession heartbeat since
-// respective given 'moment'.
-func (s *State) ExpireSessions(ctx context.Context, clientMoment, agentMoment time.Time) {
+// respective given 'moment'. The client sessions that were pruned are returned.
+func (s *State) ExpireSessions(ctx context.Context, clientMoment, agentMoment time.Time) map[string]*rpc.ClientInfo {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	var expiredClients map[string]*rpc.ClientInfo
 	for id, sess := range s.sessions {
 		if _, ok := sess.(*clientSessionState); ok {
 			if sess.LastMarked().Before(clientMoment) {
 				dlog.Debugf(ctx, "Client Session %s removed. It has expired", id)
+				if client, ok := s.clients.Load(id); ok {
+					if expiredClients == nil {
+						expiredClients = make(map[string]*rpc.ClientInfo)
+					}
+					expiredClients[id] = client
+				}
 				s.unlockedRemoveSession(id)
+				s.metrics.sessionsExpired.WithLabelValues("client").Inc()
 			}
 		} else {
 			if sess.LastMarked().Before(agentMoment) {
 				dlog.Debugf(ctx, "Agent Session %s removed. It has expired", id)
 				s.unlockedRemoveSession(id)
+				s.metrics.sessionsExpired.WithLabelValues("agent").Inc()
 			}
 		}
 	}
+	return expiredClients
 }
 
 // SessionDone returns a channel that is closed when the session with the given ID terminates.  If
@@ -259,10 +326,35 @@ func (s *State) AddClient(client *rpc.ClientInfo, now time.Time) string {
 	// the session ID also exists in external systems (the client, SystemA), so it's confusing
 	// (to both humans and computers) if the manager restarts and those existing session IDs
 	// suddenly refer to different sessions.
+	if sessionID, ok := s.resumeClient(client, now); ok {
+		return sessionID
+	}
 	sessionID := uuid.New().String()
 	return s.addClient(sessionID, client, now)
 }
 
+// resumeClient returns the ID of a restored session of a client with the same install ID and name as the
+// given client, so that a client that returns after a restart of the traffic-manager re-attaches to its
+// previous session and intercepts. The session's client info is replaced with the given client.
+func (s *State) resumeClient(client *rpc.ClientInfo, now time.Time) (string, bool) {
+	installID := client.GetInstallId()
+	if installID == "" {
+		return "", false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for sessionID := range s.restored {
+		if old, ok := s.clients.Load(sessionID); ok && old.InstallId == installID && old.Name == client.Name {
+			delete(s.restored, sessionID)
+			s.clients.Store(sessionID, client)
+			s.sessions[sessionID].SetLastMarked(now)
+			dlog.Infof(s.ctx, "Client %s re-attached to restored session %s", client.Name, sessionID)
+			return sessionID, true
+		}
+	}
+	return "", false
+}
+
 // addClient is like AddClient, but takes a sessionID, for testing purposes.
 func (s *State) addClient(sessionID string, client *rpc.ClientInfo, now time.Time) string {
 	s.mu.Lock()
@@ -306,6 +398,10 @@ func (s *State) AddAgent(agent *rpc.AgentInfo, now time.Time) string {
 	defer s.mu.Unlock()
 
 	sessionID := uuid.New().String()
+	if at, ok := s.agentRemovals[agent.Name+"."+agent.Namespace]; ok {
+		agent = proto.Clone(agent).(*rpc.AgentInfo)
+		agent.IdleRemoval = timestamppb.New(at)
+	}
 	if oldAgent, hasConflict := s.agents.LoadOrStore(sessionID, agent); hasConflict {
 		panic(fmt.Errorf("duplicate id %q, existing %+v, new %+v", sessionID, oldAgent, agent))
 	}
@@ -320,7 +416,11 @@ func (s *State) AddAgent(agent *rpc.AgentInfo, now time.Time) string {
 		// Check whether each intercept needs to either (1) be moved in to a NO_AGENT state
 		// because this agent made things inconsistent, or (2) be moved out of a NO_AGENT
 		// state because it just gained an agent.
+		selectionChanged := s.unlockedSelectPods(intercept, "")
 		if errCode, errMsg := s.unlockedCheckAgentsForIntercept(intercept); errCode != 0 {
+			if intercept.Disposition != errCode {
+				s.metrics.interceptFailed(errCode)
+			}
 			intercept.Disposition = errCode
 			intercept.Message = errMsg
 			s.intercepts.Store(interceptID, intercept)
@@ -328,6 +428,8 @@ func (s *State) AddAgent(agent *rpc.AgentInfo, now time.Time) string {
 			intercept.Disposition = rpc.InterceptDispositionType_WAITING
 			intercept.Message = ""
 			s.intercepts.Store(interceptID, intercept)
+		} else if selectionChanged {
+			s.intercepts.Store(interceptID, intercept)
 		}
 	}
 	return sessionID
@@ -356,6 +458,40 @@ func (s *State) GetAgentsByName(name, namespace string) map[string]*rpc.AgentInf
 	return ret
 }
 
+// HasIntercepts returns true if the agent of the given workload has intercepts, regardless of their disposition.
+func (s *State) HasIntercepts(agentName, namespace string) bool {
+	return len(s.intercepts.LoadAllMatching(func(_ string, ii *rpc.InterceptInfo) bool {
+		return ii.Spec.Agent == agentName && ii.Spec.Namespace == namespace
+	})) > 0
+}
+
+// SetAgentIdleRemoval records the time when the agent of the given workload will be removed because it
+// isn't intercepted, and reports it in the info of the agents. A zero time means that it won't be removed.
+func (s *State) SetAgentIdleRemoval(agentName, namespace string, at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := agentName + "." + namespace
+	if s.agentRemovals[key].Equal(at) {
+		return
+	}
+	var ts *timestamppb.Timestamp
+	if at.IsZero() {
+		delete(s.agentRemovals, key)
+	} else {
+		s.agentRemovals[key] = at
+		ts = timestamppb.New(at)
+	}
+	for sessionID, agent := range s.agentsByName[agentName] {
+		if agent.Namespace == namespace {
+			agent = proto.Clone(agent).(*rpc.AgentInfo)
+			agent.IdleRemoval = ts
+			s.agentsByName[agentName][sessionID] = agent
+			s.agents.Store(sessionID, agent)
+		}
+	}
+}
+
 func (s *State) WatchAgents(
 	ctx context.Context,
 	filter func(sessionID string, agent *rpc.AgentInfo) bool,
@@ -369,14 +505,19 @@ func (s *State) WatchAgents(
 
 // Intercepts //////////////////////////////////////////////////////////////////////////////////////
 
-func (s *State) AddIntercept(sessionID, clusterID, apiKey string, client *rpc.ClientInfo, spec *rpc.InterceptSpec) (*rpc.InterceptInfo, error) {
+func (s *State) AddIntercept(sessionID, clusterID, apiKey string, client *rpc.ClientInfo, spec *rpc.InterceptSpec, now time.Time) (*rpc.InterceptInfo, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	sess, ok := s.sessions[sessionID].(*clientSessionState)
 	if sess == nil || !ok {
+		s.metrics.interceptFailed(rpc.InterceptDispositionType_NO_CLIENT)
 		return nil, status.Errorf(codes.NotFound, "session %q not found", sessionID)
 	}
+	if spec.Mirror && spec.Mechanism != "tcp" {
+		s.metrics.interceptFailed(rpc.InterceptDispositionType_BAD_ARGS)
+		return nil, status.Errorf(codes.InvalidArgument, "intercept %q cannot mirror traffic using mechanism %q", spec.Name, spec.Mechanism)
+	}
 
 	interceptID := fmt.Sprintf("%s:%s", sessionID, spec.Name)
 	installId := client.GetInstallId()
@@ -392,6 +533,9 @@ func (s *State) AddIntercept(sessionID, clusterID, apiKey string, client *rpc.Cl
 		},
 		ApiKey: apiKey,
 	}
+	if ttl := spec.Ttl.AsDuration(); ttl > 0 {
+		cept.ExpiresAt = timestamppb.New(now.Add(ttl))
+	}
 
 	// Wrap each potential-state-change in a
 	//
@@ -404,13 +548,19 @@ func (s *State) AddIntercept(sessionID, clusterID, apiKey string, client *rpc.Cl
 			cept.Message = errMsg
 		}
 	}
+	s.unlockedSelectPods(cept, "")
 
 	if _, hasConflict := s.intercepts.LoadOrStore(cept.Id, cept); hasConflict {
+		s.metrics.interceptFailedWithReason(reasonAlreadyExists)
 		return nil, status.Errorf(codes.AlreadyExists, "Intercept named %q already exists", spec.Name)
 	}
 
-	state := newInterceptState(cept.Id)
+	state := newInterceptState(cept.Id, now)
 	s.interceptStates[interceptID] = state
+	s.metrics.interceptsCreated.Inc()
+	if isErrorDisposition(cept.Disposition) {
+		s.metrics.interceptFailed(cept.Disposition)
+	}
 
 	return cept, nil
 }
@@ -426,6 +576,88 @@ func (s *State) AddInterceptFinalizer(interceptID string, finalizer InterceptFin
 	return nil
 }
 
+// SetAgentFallback records whether the agent with the given session ID sends the traffic of the given
+// ACTIVE intercept to the intercepted container because the intercept's target is unreachable. The
+// intercept's FallbackActive is true while any of its agents falls back, and its Message then tells
+// why. The updated intercept is returned, or nil if there is no such intercept.
+func (s *State) SetAgentFallback(interceptID, agentSessionID string, active bool, reason string) *rpc.InterceptInfo {
+	s.mu.RLock()
+	is, ok := s.interceptStates[interceptID]
+	s.mu.RUnlock()
+	if !ok {
+		return nil
+	}
+	is.setFallback(agentSessionID, active, reason)
+	return s.UpdateIntercept(interceptID, func(intercept *rpc.InterceptInfo) {
+		if intercept.Disposition == rpc.InterceptDispositionType_ACTIVE {
+			intercept.FallbackActive, intercept.Message = is.fallback()
+		}
+	})
+}
+
+// unlockedSelectPods assigns the pods of the agents that serve an intercept that is limited to a pod
+// or a number of replicas. Pods that are selected remain selected for as long as their agents are
+// present, so that the intercept doesn't move between replicas. The agent with the given session ID
+// is about to leave and cannot be selected. Returns true if the selection changed.
+func (s *State) unlockedSelectPods(intercept *rpc.InterceptInfo, leavingSessionID string) bool {
+	spec := intercept.Spec
+	var selected []string
+	switch {
+	case spec.Pod != "":
+		selected = []string{spec.Pod}
+	case spec.Replicas > 0:
+		present := make(map[string]struct{})
+		pods := make([]string, 0, len(s.agentsByName[spec.Agent]))
+		for sessionID, agent := range s.agentsByName[spec.Agent] {
+			if sessionID != leavingSessionID && agent.Namespace == spec.Namespace && agent.PodName != "" {
+				present[agent.PodName] = struct{}{}
+				pods = append(pods, agent.PodName)
+			}
+		}
+		sort.Strings(pods)
+		candidates := append(append(make([]string, 0, len(intercept.SelectedPods)+len(pods)), intercept.SelectedPods...), pods...)
+		for _, pod := range candidates {
+			if len(selected) == int(spec.Replicas) {
+				break
+			}
+			if _, ok := present[pod]; ok {
+				selected = append(selected, pod)
+				delete(present, pod)
+			}
+		}
+	default:
+		return false
+	}
+	if len(selected) == len(intercept.SelectedPods) {
+		equal := true
+		for i, pod := range selected {
+			if pod != intercept.SelectedPods[i] {
+				equal = false
+				break
+			}
+		}
+		if equal {
+			return false
+		}
+	}
+	intercept.SelectedPods = selected
+	return true
+}
+
+// AgentServesIntercept returns true if the given agent serves the given intercept. All agents of the
+// intercepted workload serve an intercept, unless it's limited to a pod or a number of replicas.
+func AgentServesIntercept(agent *rpc.AgentInfo, intercept *rpc.InterceptInfo) bool {
+	if intercept.Spec.Pod == "" && intercept.Spec.Replicas == 0 {
+		return true
+	}
+	for _, pod := range intercept.SelectedPods {
+		if pod == agent.PodName {
+			return true
+		}
+	}
+	return false
+}
+
 // getAgentsInterceptedByClient returns the session IDs for each agent that are currently
 // intercepted by the client with the given client session ID.
 func (s *State) getAgentsInterceptedByClient(clientSessionID string) []string {
@@ -477,6 +709,9 @@ func (s *State) UpdateIntercept(interceptID string, apply func(*rpc.InterceptInf
 		swapped := s.intercepts.CompareAndSwap(newInfo.Id, cur, newInfo)
 		if swapped {
 			// Success!
+			if newInfo.Disposition != cur.Disposition && isErrorDisposition(newInfo.Disposition) {
+				s.metrics.interceptFailed(newInfo.Disposition)
+			}
 			return newInfo
 		}
 	}
@@ -489,19 +724,124 @@ func (s *State) RemoveIntercept(interceptID string) bool {
 }
 
 func (s *State) unlockedRemoveIntercept(interceptID string) bool {
-	intercept, didDelete := s.intercepts.LoadAndDelete(interceptID)
-	if state, ok := s.interceptStates[interceptID]; ok && didDelete {
-		delete(s.interceptStates, interceptID)
+	intercept, state := s.unlockedDeleteIntercept(interceptID)
+	if state != nil {
 		state.terminate(s.ctx, intercept)
 	}
+	return intercept != nil
+}
+
+// unlockedDeleteIntercept deletes the intercept with the given ID without running its finalizers. The deleted
+// intercept is returned together with its state, which the caller must terminate. The intercept is nil when
+// there is no such intercept.
+func (s *State) unlockedDeleteIntercept(interceptID string) (*rpc.InterceptInfo, *interceptState) {
+	intercept, didDelete := s.intercepts.LoadAndDelete(interceptID)
+	if !didDelete {
+		return nil, nil
+	}
+	s.metrics.interceptsRemoved.Inc()
+	state := s.interceptStates[interceptID]
+	delete(s.interceptStates, interceptID)
+	return intercept, state
+}
+
+// ExpiredIntercept is an intercept that was removed by ExpireIntercepts.
+type ExpiredIntercept struct {
+	Info *rpc.InterceptInfo
+
+	// Message is a human-friendly explanation of why the intercept expired.
+	Message string
+}
+
+// ExpireIntercepts removes the intercepts that have outlived their time-to-live, and the intercepts
+// that haven't routed any traffic to their client during their idle timeout. The finalizers of the
+// removed intercepts are run just as if the client had removed them, but without holding the
+// state's lock. The removed intercepts are returned.
+func (s *State) ExpireIntercepts(ctx context.Context, now time.Time) []ExpiredIntercept {
+	var expired []ExpiredIntercept
+	var states []*interceptState
+	s.mu.Lock()
+	for id, intercept := range s.intercepts.LoadAll() {
+		is, ok := s.interceptStates[id]
+		if !ok {
+			continue
+		}
+		if reason, msg := is.expiry(intercept, now); reason != "" {
+			dlog.Infof(ctx, "Intercept %s removed because %s", id, msg)
+			if deleted, _ := s.unlockedDeleteIntercept(id); deleted != nil {
+				s.metrics.interceptsExpired.WithLabelValues(reason).Inc()
+				expired = append(expired, ExpiredIntercept{Info: deleted, Message: msg})
+				states = append(states, is)
+			}
+		}
+	}
+	s.mu.Unlock()
+
+	for i, is := range states {
+		is.terminate(s.ctx, expired[i].Info)
+	}
+	return expired
+}
+
+// interceptActivity returns a stream that records each message with payload that it sends or receives
+// as activity of the intercepts of the given agent that are owned by the client with the given session
+// ID. The given stream routes intercepted traffic between that agent and client. Streams that are
+// open without routing any traffic, such as pooled HTTP connections, don't keep the intercepts
+// alive. The given now function provides the time of the activity.
+func (s *State) interceptActivity(stream tunnel.Stream, agent *rpc.AgentInfo, clientSessionID string, now func() time.Time) tunnel.Stream {
+	s.mu.RLock()
+	var iss []*interceptState
+	for id, ii := range s.intercepts.LoadAll() {
+		if ii.ClientSession.SessionId == clientSessionID && ii.Spec.Agent == agent.Name && ii.Spec.Namespace == agent.Namespace {
+			if is, ok := s.interceptStates[id]; ok {
+				iss = append(iss, is)
+			}
+		}
+	}
+	s.mu.RUnlock()
+	if len(iss) == 0 {
+		return stream
+	}
+	return &activityStream{Stream: stream, iss: iss, now: now}
+}
+
+// activityStream records the messages with payload that pass through it as intercept activity.
+type activityStream struct {
+	tunnel.Stream
+	iss []*interceptState
+	now func() time.Time
+}
+
+func (as *activityStream) record() {
+	now := as.now()
+	for _, is := range as.iss {
+		is.recordActivity(now)
+	}
+}
+
+func (as *activityStream) Receive(ctx context.Context) (tunnel.Message, error) {
+	m, err := as.Stream.Receive(ctx)
+	if err == nil && m.Code() == tunnel.Normal {
+		as.record()
+	}
+	return m, err
+}
 
-	return didDelete
+func (as *activityStream) Send(ctx context.Context, m tunnel.Message) error {
+	if m.Code() == tunnel.Normal {
+		as.record()
+	}
+	return as.Stream.Send(ctx, m)
 }
 
 func (s *State) GetIntercept(interceptID string) (*rpc.InterceptInfo, bool) {
 	return s.intercepts.Load(interceptID)
 }
 
+func (s *State) GetAllIntercepts() map[string]*rpc.InterceptInfo {
+	return s.intercepts.LoadAll()
+}
+
 func (s *State) WatchIntercepts(
 	ctx context.Context,
 	filter func(sessionID string, intercept *rpc.InterceptInfo) bool,
@@ -513,7 +853,9 @@ func (s *State) WatchIntercepts(
 	}
 }
 
-func (s *State) Tunnel(ctx context.Context, stream tunnel.Stream) error {
+// Tunnel connects the given stream with its peer. The given now function provides the time that is
+// used when tracking the activity of intercepts.
+func (s *State) Tunnel(ctx context.Context, stream tunnel.Stream, now func() time.Time) error {
 	ctx, span := otel.Tracer("").Start(ctx, "state.Tunnel")
 	defer span.End()
 	stream.ID().SpanRecord(span)
@@ -525,6 +867,8 @@ func (s *State) Tunnel(ctx context.Context, stream tunnel.Stream) error {
 	if !ok {
 		return status.Errorf(codes.NotFound, "Session %q not found", sessionID)
 	}
+	stream, done := s.metrics.meterStream(stream)
+	defer done()
 
 	bidiPipe, err := ss.OnConnect(ctx, stream)
 	if err != nil {
@@ -561,9 +905,21 @@ func (s *State) Tunnel(ctx context.Context, stream tunnel.Stream) error {
 		s.mu.RLock()
 		peerSession = s.sessions[peerID]
 		s.mu.RUnlock()
+		// Health probes of the client's intercept target aren't intercepted traffic.
+		if agent, ok := s.agents.Load(sessionID); ok && !stream.ID().IsProbe() {
+			stream = s.interceptActivity(stream, agent, peerID, now)
+		}
 	} else {
 		span.SetAttributes(attribute.String("session-type", "userd"))
-		peerSession = s.getRandomAgentSession(sessionID)
+		if client := s.GetClient(sessionID); client.GetEgressWorkload() != "" {
+			if peerSession = s.getEgressAgentSession(client.EgressWorkload, client.EgressNamespace); peerSession == nil {
+				return status.Errorf(codes.Unavailable, "no traffic-agent of %s.%s is connected to the traffic-manager",
+					client.EgressWorkload, client.EgressNamespace)
+			}
+			span.SetAttributes(attribute.String("egress-via", client.EgressWorkload+"."+client.EgressNamespace))
+		} else {
+			peerSession = s.getRandomAgentSession(sessionID)
+		}
 	}
 
 	var endPoint tunnel.Endpoint
@@ -589,6 +945,23 @@ func (s *State) getRandomAgentSession(clientSessionID string) (agent SessionStat
 	return
 }
 
+// getEgressAgentSession returns the session of one of the traffic-agents of the given workload, or
+// nil if no such traffic-agent is connected. The iteration order of the agent map is random, so the
+// connections are spread over the replicas of the workload.
+func (s *State) getEgressAgentSession(workload, namespace string) (agent SessionState) {
+	agents := s.agents.LoadAllMatching(func(_ string, ai *rpc.AgentInfo) bool {
+		return ai.Name == workload && ai.Namespace == namespace
+	})
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for id := range agents {
+		if agent = s.sessions[id]; agent != nil {
+			break
+		}
+	}
+	return agent
+}
+
 func (s *State) WatchDial(sessionID string) chan *rpc.DialRequest {
 	s.mu.RLock()
 	ss, ok := s.sessions[sessionID]
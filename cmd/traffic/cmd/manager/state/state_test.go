@@ -5,6 +5,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
 	testdata "github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/internal/test"
 	manager "github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/state"
 )
@@ -124,4 +128,88 @@ func TestStateInternal(topT *testing.T) {
 		a.False(state.Mark(c2, clock.Now()))
 		a.False(state.Mark(c3, clock.Now()))
 	})
+	topT.Run("intercept-expiry", func(t *testing.T) {
+		a := assertNew(t)
+
+		clock := &FakeClock{}
+		state := manager.NewState(ctx)
+		state.AddAgent(testAgents["hello"], clock.Now())
+		c1 := state.AddClient(testClients["alice"], clock.Now())
+
+		addIntercept := func(name string, ttl, idleTimeout time.Duration) string {
+			spec := &rpc.InterceptSpec{
+				Name: name, Client: "alice", Agent: "hello", Namespace: "default", Mechanism: "tcp",
+			}
+			if ttl > 0 {
+				spec.Ttl = durationpb.New(ttl)
+			}
+			if idleTimeout > 0 {
+				spec.IdleTimeout = durationpb.New(idleTimeout)
+			}
+			ii, err := state.AddIntercept(c1, "", "", testClients["alice"], spec, clock.Now())
+			require.NoError(t, err)
+			return ii.Id
+		}
+		forever := addIntercept("forever", 0, 0)
+		ttl := addIntercept("ttl", time.Hour, 0)
+		idle := addIntercept("idle", 0, 10*time.Minute)
+
+		finalized := false
+		require.NoError(t, state.AddInterceptFinalizer(ttl, func(context.Context, *rpc.InterceptInfo) error {
+			// Finalizers run without the state's lock, so they can use the state.
+			_, err := state.SessionDone(c1)
+			finalized = err == nil
+			return nil
+		}))
+		now := clock.Now()
+		ii, _ := state.GetIntercept(ttl)
+		a.Equal(now.Add(time.Hour), ii.ExpiresAt.AsTime())
+
+		state.ExpireIntercepts(ctx, now)
+		_, ok := state.GetIntercept(idle)
+		a.True(ok)
+
+		state.ExpireIntercepts(ctx, now.Add(15*time.Minute))
+		_, ok = state.GetIntercept(idle)
+		a.False(ok)
+		_, ok = state.GetIntercept(ttl)
+		a.True(ok)
+
+		state.ExpireIntercepts(ctx, now.Add(2*time.Hour))
+		_, ok = state.GetIntercept(ttl)
+		a.False(ok)
+		a.True(finalized)
+		_, ok = state.GetIntercept(forever)
+		a.True(ok)
+	})
+	topT.Run("agent-idle-removal", func(t *testing.T) {
+		a := assertNew(t)
+
+		clock := &FakeClock{}
+		state := manager.NewState(ctx)
+		hello := testAgents["hello"]
+		state.AddAgent(hello, clock.Now())
+		c1 := state.AddClient(testClients["alice"], clock.Now())
+		a.False(state.HasIntercepts(hello.Name, hello.Namespace))
+
+		removal := clock.Now().Add(time.Hour)
+		state.SetAgentIdleRemoval(hello.Name, hello.Namespace, removal)
+		for _, agent := range state.GetAllAgents() {
+			a.Equal(removal, agent.IdleRemoval.AsTime())
+		}
+
+		// Agents that arrive later get the same removal time.
+		sessionID := state.AddAgent(testAgents["hello"], clock.Now())
+		a.Equal(removal, state.GetAgent(sessionID).IdleRemoval.AsTime())
+
+		_, err := state.AddIntercept(c1, "", "", testClients["alice"], &rpc.InterceptSpec{
+			Name: "hello", Client: "alice", Agent: hello.Name, Namespace: hello.Namespace, Mechanism: "tcp",
+		}, clock.Now())
+		require.NoError(t, err)
+		a.True(state.HasIntercepts(hello.Name, hello.Namespace))
+		state.SetAgentIdleRemoval(hello.Name, hello.Namespace, time.Time{})
+		for _, agent := range state.GetAllAgents() {
+			a.Nil(agent.IdleRemoval)
+		}
+	})
 }
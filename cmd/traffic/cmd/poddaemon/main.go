@@ -254,7 +254,13 @@ func connectError(info *rpc_userd.ConnectInfo) error {
 func summarizeIntercept(icept *rpc_manager.InterceptInfo) (summary string, iceptIsOK bool) {
 	iceptIsOK = true
 	summary = fmt.Sprintf("intercept name=%q (id=%q) state: ", icept.Spec.Name, icept.Id)
-	if icept.Disposition > rpc_manager.InterceptDispositionType_WAITING {
+	switch icept.Disposition {
+	case rpc_manager.InterceptDispositionType_UNSPECIFIED,
+		rpc_manager.InterceptDispositionType_ACTIVE,
+		rpc_manager.InterceptDispositionType_WAITING,
+		rpc_manager.InterceptDispositionType_PAUSED,
+		rpc_manager.InterceptDispositionType_DRAINING:
+	default:
 		summary += "error: "
 		iceptIsOK = false
 	}
@@ -163,6 +163,33 @@ func InitContainer(config *Sidecar) *core.Container {
 	return ic
 }
 
+// NativeSidecar is a traffic-agent container that is injected as an init container with restartPolicy
+// "Always", i.e. as a native sidecar. Such a container starts before the app containers and doesn't
+// prevent a pod from completing. The restartPolicy of a container isn't yet part of the Kubernetes API
+// that this module uses, so it is added here.
+type NativeSidecar struct {
+	core.Container
+	RestartPolicy string `json:"restartPolicy"`
+}
+
+// NewNativeSidecar returns the given container as a native sidecar.
+func NewNativeSidecar(cn *core.Container) *NativeSidecar {
+	return &NativeSidecar{Container: *cn, RestartPolicy: "Always"}
+}
+
+// FindAgentContainer returns the traffic-agent container of the given pod spec, or nil if it has none. The
+// container is an init container when it was injected as a native sidecar.
+func FindAgentContainer(ps *core.PodSpec) *core.Container {
+	for _, cns := range [][]core.Container{ps.Containers, ps.InitContainers} {
+		for i := range cns {
+			if cns[i].Name == ContainerName {
+				return &cns[i]
+			}
+		}
+	}
+	return nil
+}
+
 func AgentVolumes(agentName string, pod *core.Pod) []core.Volume {
 	var items []core.KeyToPath
 	if agentName != "" {
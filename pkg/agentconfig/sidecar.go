@@ -43,6 +43,7 @@ const (
 
 	DomainPrefix                   = "telepresence.getambassador.io/"
 	InjectAnnotation               = DomainPrefix + "inject-" + ContainerName
+	KeepAnnotation                 = DomainPrefix + "keep-" + ContainerName
 	TerminatingTLSSecretAnnotation = DomainPrefix + "inject-terminating-tls-secret"
 	OriginatingTLSSecretAnnotation = DomainPrefix + "inject-originating-tls-secret"
 )
@@ -0,0 +1,57 @@
+package agentconfig
+
+import (
+	"fmt"
+)
+
+// SidecarMode specifies how the agent injector mutating webhook injects the traffic-agent into a pod.
+type SidecarMode int
+
+var smNames = [...]string{"Auto", "Native", "Container"} //nolint:gochecknoglobals // constant names
+
+const (
+	// SidecarModeAuto tells the injector to inject the traffic-agent as a native sidecar when the
+	// Kubernetes server enables native sidecars by default, and as a regular container otherwise.
+	//
+	// This is the default setting.
+	SidecarModeAuto SidecarMode = iota
+
+	// SidecarModeNative tells the injector to always inject the traffic-agent as a native sidecar, i.e.
+	// as an init container with restartPolicy "Always".
+	SidecarModeNative
+
+	// SidecarModeContainer tells the injector to always inject the traffic-agent as a regular container.
+	SidecarModeContainer
+)
+
+func (sm SidecarMode) String() string {
+	return smNames[sm]
+}
+
+func NewSidecarMode(s string) (SidecarMode, error) {
+	for i, n := range smNames {
+		if s == n {
+			return SidecarMode(i), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid SidecarMode: %q", s)
+}
+
+func (sm SidecarMode) MarshalJSON() ([]byte, error) {
+	return []byte(sm.String()), nil
+}
+
+func (sm *SidecarMode) EnvDecode(val string) (err error) {
+	var as SidecarMode
+	if val == "" {
+		as = SidecarModeAuto
+	} else if as, err = NewSidecarMode(val); err != nil {
+		return err
+	}
+	*sm = as
+	return nil
+}
+
+func (sm *SidecarMode) UnmarshalJSON(value []byte) error {
+	return sm.EnvDecode(string(value))
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/datawire/k8sapi/pkg/k8sapi"
 	"github.com/telepresenceio/telepresence/v2/pkg/tracing"
+	"github.com/telepresenceio/telepresence/v2/pkg/workload"
 )
 
 func FindOwnerWorkload(ctx context.Context, obj k8sapi.Object) (k8sapi.Workload, error) {
@@ -35,6 +36,10 @@ func FindOwnerWorkload(ctx context.Context, obj k8sapi.Object) (k8sapi.Workload,
 	if wl, ok := obj.(k8sapi.Workload); ok {
 		return wl, nil
 	}
+	if pod, ok := k8sapi.PodImpl(obj); ok && workload.IsBarePod(pod) {
+		// A pod that isn't controlled by anything is its own workload.
+		return workload.Pod(pod), nil
+	}
 	return nil, fmt.Errorf("unable to find workload owner for %s.%s", obj.GetName(), obj.GetNamespace())
 }
 
@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/telepresenceio/telepresence/rpc/v2/daemon"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/ann"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/connect"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/output"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/socket"
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+)
+
+type connectionsCommand struct {
+	watch    bool
+	interval time.Duration
+}
+
+func connections() *cobra.Command {
+	cc := &connectionsCommand{}
+	cmd := &cobra.Command{
+		Use:  "connections",
+		Args: cobra.NoArgs,
+
+		Short: "List the connections that are routed to the cluster",
+		Long: "List the connections that the network daemon routes to the cluster. Each connection is shown with " +
+			"its protocol, source and destination, the name of the service that the destination resolved from, " +
+			"the number of bytes sent and received, and its age.",
+		RunE: cc.run,
+		Annotations: map[string]string{
+			ann.Session: ann.Required,
+		},
+	}
+	flags := cmd.Flags()
+	flags.BoolVarP(&cc.watch, "watch", "w", false, "keep listing the connections until interrupted")
+	flags.DurationVar(&cc.interval, "interval", time.Second, "time between each listing when using --watch")
+	return cmd
+}
+
+func (cc *connectionsCommand) run(cmd *cobra.Command, _ []string) error {
+	if err := connect.InitCommand(cmd); err != nil {
+		return err
+	}
+	ctx := cmd.Context()
+	conn, err := socket.Dial(ctx, socket.DaemonName)
+	if err != nil {
+		return errcat.User.Newf("unable to connect to the network daemon: %v", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := daemon.NewDaemonClient(conn).WatchConnections(ctx, &daemon.WatchConnectionsRequest{
+		Interval: durationpb.New(cc.interval),
+	})
+	if err != nil {
+		return err
+	}
+
+	watch := cc.watch || output.WantsStream(cmd)
+	formatted := output.WantsFormatted(cmd)
+	stdout := cmd.OutOrStdout()
+	for {
+		cs, err := stream.Recv()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		if formatted {
+			output.Object(ctx, cs.Connections, false)
+		} else {
+			if watch {
+				// Clear the screen so that the table is redrawn in place.
+				fmt.Fprint(stdout, "\033[H\033[2J")
+			}
+			printConnections(stdout, cs.Connections)
+		}
+		if !watch {
+			return nil
+		}
+	}
+}
+
+func printConnections(out io.Writer, cs []*daemon.Connection) {
+	if len(cs) == 0 {
+		fmt.Fprintln(out, "No connections")
+		return
+	}
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "PROTO\tSOURCE\tDESTINATION\tSERVICE\tSENT\tRECEIVED\tAGE")
+	for _, c := range cs {
+		svc := c.ServiceName
+		if svc == "" {
+			svc = "-"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d\t%d\t%s\n",
+			c.Protocol, c.Source, c.Destination, svc, c.BytesSent, c.BytesReceived, c.Age.AsDuration().Truncate(time.Second))
+	}
+	_ = tw.Flush()
+}
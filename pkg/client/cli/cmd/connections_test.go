@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/telepresenceio/telepresence/rpc/v2/daemon"
+)
+
+func Test_printConnections(t *testing.T) {
+	buf := bytes.Buffer{}
+	printConnections(&buf, nil)
+	assert.Equal(t, "No connections\n", buf.String())
+
+	buf.Reset()
+	printConnections(&buf, []*daemon.Connection{
+		{
+			Protocol:      "tcp",
+			Source:        "10.0.0.1:51234",
+			Destination:   "10.96.0.10:80",
+			ServiceName:   "echo.default",
+			BytesSent:     120,
+			BytesReceived: 4096,
+			Age:           durationpb.New(90*time.Second + 300*time.Millisecond),
+		},
+		{
+			Protocol:    "udp",
+			Source:      "10.0.0.1:40000",
+			Destination: "10.96.0.11:53",
+			Age:         durationpb.New(time.Second),
+		},
+	})
+	assert.Equal(t, ""+
+		"PROTO  SOURCE          DESTINATION    SERVICE       SENT  RECEIVED  AGE\n"+
+		"tcp    10.0.0.1:51234  10.96.0.10:80  echo.default  120   4096      1m30s\n"+
+		"udp    10.0.0.1:40000  10.96.0.11:53  -             0     0         1s\n",
+		buf.String())
+}
@@ -0,0 +1,423 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/emptypb"
+	auth "k8s.io/api/authorization/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/telepresenceio/telepresence/rpc/v2/connector"
+	rootdRpc "github.com/telepresenceio/telepresence/rpc/v2/daemon"
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/pkg/client"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/ann"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/connect"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/daemon"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/output"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/socket"
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+	"github.com/telepresenceio/telepresence/v2/pkg/ipproto"
+	"github.com/telepresenceio/telepresence/v2/pkg/iputil"
+	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
+	"github.com/telepresenceio/telepresence/v2/pkg/vif/routing"
+)
+
+// dnsCheckService is the service that the DNS check resolves. The kubernetes service in the default
+// namespace exists in every cluster.
+const dnsCheckService = "kubernetes.default"
+
+type checkStatus string
+
+const (
+	checkPass checkStatus = "pass"
+	checkFail checkStatus = "fail"
+	checkSkip checkStatus = "skip"
+)
+
+// errSkipCheck is returned by a check that isn't applicable.
+var errSkipCheck = errors.New("skipped") //nolint:gochecknoglobals // constant
+
+// doctorCheck is the outcome of one step in the connection path.
+type doctorCheck struct {
+	Name     string      `json:"name" yaml:"name"`
+	Status   checkStatus `json:"status" yaml:"status"`
+	Message  string      `json:"message,omitempty" yaml:"message,omitempty"`
+	Duration string      `json:"duration,omitempty" yaml:"duration,omitempty"`
+}
+
+type doctorReport struct {
+	Checks []*doctorCheck `json:"checks" yaml:"checks"`
+}
+
+func (r *doctorReport) WriteTo(out io.Writer) (int64, error) {
+	var n int
+	for _, c := range r.Checks {
+		mark := good
+		switch c.Status {
+		case checkFail:
+			mark = bad
+		case checkSkip:
+			mark = "➖"
+		}
+		nn, err := fmt.Fprintf(out, "%s %-16s %s\n", mark, c.Name, c.Message)
+		n += nn
+		if err != nil {
+			return int64(n), err
+		}
+	}
+	return int64(n), nil
+}
+
+func (r *doctorReport) failed() bool {
+	for _, c := range r.Checks {
+		if c.Status == checkFail {
+			return true
+		}
+	}
+	return false
+}
+
+type doctorCommand struct {
+	request *daemon.Request
+	timeout time.Duration
+
+	// Information collected by one check and used by those that follow.
+	clusterInfo *manager.ClusterInfo
+	remote      bool
+	tun         *net.Interface
+	subnets     []*net.IPNet
+	dnsCheck    *rootdRpc.CheckDNSResponse
+}
+
+// skipRemote is the result of the checks of the local network when the daemon runs in a container.
+func (dc *doctorCommand) skipRemote() (string, error) {
+	return "the daemon runs in a container", errSkipCheck
+}
+
+func doctor() *cobra.Command {
+	dc := &doctorCommand{}
+	cmd := &cobra.Command{
+		Use:  "doctor",
+		Args: cobra.NoArgs,
+
+		Short: "Check each hop in the connection path to the cluster",
+		Long: `Check each hop in the connection path to the cluster, in order: authentication using the kubeconfig,
+the connection to the traffic-manager, a round trip through the tunnel, the network device created by the
+root daemon, routing conflicts, recursion of DNS queries, DNS resolution, and dialing a pod and a service in the cluster.
+
+A check is skipped when a check that it depends on fails. The command exits with an error when a check fails.
+Use --output=json or --output=yaml to get the result in a structured form.`,
+		Annotations: map[string]string{
+			ann.Session: ann.Required,
+		},
+		SilenceUsage: true,
+		RunE:         dc.run,
+	}
+	dc.request = daemon.InitRequest(cmd)
+	cmd.Flags().DurationVar(&dc.timeout, "timeout", 10*time.Second, "Max time to spend on each check")
+	return cmd
+}
+
+func (dc *doctorCommand) run(cmd *cobra.Command, _ []string) error {
+	dc.request.CommitFlags(cmd)
+	report := &doctorReport{}
+	checks := []struct {
+		name string
+		fn   func(*cobra.Command) (string, error)
+	}{
+		{"kubeconfig", dc.checkKubeconfig},
+		{"traffic-manager", dc.checkTrafficManager},
+		{"tunnel", dc.checkTunnel},
+		{"network-device", dc.checkNetworkDevice},
+		{"routing", dc.checkRouting},
+		{"dns-recursion", dc.checkDNSRecursion},
+		{"dns", dc.checkDNS},
+		{"pod", dc.checkPodDial},
+		{"service", dc.checkServiceDial},
+	}
+	failed := false
+	for _, c := range checks {
+		dcr := &doctorCheck{Name: c.name}
+		report.Checks = append(report.Checks, dcr)
+		if failed {
+			// All checks depend on the ones that precede them.
+			dcr.Status = checkSkip
+			dcr.Message = "skipped due to an earlier failure"
+			continue
+		}
+		start := time.Now()
+		msg, err := c.fn(cmd)
+		dcr.Duration = time.Since(start).Round(time.Millisecond).String()
+		switch {
+		case err == nil:
+			dcr.Status = checkPass
+			dcr.Message = msg
+		case errors.Is(err, errSkipCheck):
+			dcr.Status = checkSkip
+			dcr.Message = msg
+		default:
+			dcr.Status = checkFail
+			dcr.Message = err.Error()
+			failed = true
+		}
+	}
+
+	ctx := cmd.Context()
+	if output.WantsFormatted(cmd) {
+		output.Object(ctx, report, false)
+	} else {
+		_, _ = report.WriteTo(cmd.OutOrStdout())
+	}
+	if report.failed() {
+		return errcat.User.New("one or more checks failed")
+	}
+	return nil
+}
+
+func (dc *doctorCommand) checkKubeconfig(cmd *cobra.Command) (string, error) {
+	ctx, cancel := context.WithTimeout(cmd.Context(), dc.timeout)
+	defer cancel()
+	kc, err := client.NewKubeconfig(ctx, dc.request.KubeFlags, dc.request.ManagerNamespace)
+	if err != nil {
+		return "", err
+	}
+	ki, err := kubernetes.NewForConfig(kc.GetRestConfig())
+	if err != nil {
+		return "", err
+	}
+	// Any authenticated user is allowed to review its own access, so this call will only fail
+	// when the credentials are rejected or when the API server can't be reached.
+	_, err = ki.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &auth.SelfSubjectAccessReview{
+		Spec: auth.SelfSubjectAccessReviewSpec{ResourceAttributes: &auth.ResourceAttributes{
+			Namespace: kc.GetManagerNamespace(),
+			Verb:      "get",
+			Resource:  "pods",
+		}},
+	}, meta.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("unable to authenticate to %s using context %q: %w", kc.Server, kc.Context, err)
+	}
+	return fmt.Sprintf("authenticated to %s using context %q", kc.Server, kc.Context), nil
+}
+
+func (dc *doctorCommand) checkTrafficManager(cmd *cobra.Command) (string, error) {
+	if err := connect.InitCommand(cmd); err != nil {
+		return "", err
+	}
+	ctx, cancel := context.WithTimeout(cmd.Context(), dc.timeout)
+	defer cancel()
+	userD := daemon.GetUserClient(ctx)
+	ver, err := userD.TrafficManagerVersion(ctx, &emptypb.Empty{})
+	if err != nil {
+		return "", fmt.Errorf("unable to reach the traffic-manager: %w", err)
+	}
+
+	// The cluster info contains the addresses used by the checks that follow.
+	mp := connector.NewManagerProxyClient(userD.Conn)
+	wci, err := mp.WatchClusterInfo(ctx, daemon.GetSession(ctx).Info.SessionInfo)
+	if err == nil {
+		dc.clusterInfo, err = wci.Recv()
+	}
+	if err != nil {
+		return "", fmt.Errorf("unable to retrieve cluster info from the traffic-manager: %w", err)
+	}
+	return fmt.Sprintf("connected to traffic-manager %s in namespace %s", ver.Version, daemon.GetSession(ctx).Info.ManagerNamespace), nil
+}
+
+func (dc *doctorCommand) checkTunnel(cmd *cobra.Command) (string, error) {
+	ctx, cancel := context.WithTimeout(cmd.Context(), dc.timeout)
+	defer cancel()
+	ci := dc.clusterInfo
+	podIP := net.IP(ci.ManagerPodIp)
+	if podIP == nil || ci.ManagerPodPort == 0 {
+		return "the traffic-manager didn't report its pod address", errSkipCheck
+	}
+
+	// Open a tunnel to the traffic-manager's own port. The traffic-manager (or an intercepted
+	// traffic-agent) dials it and reports the outcome back through the tunnel.
+	ms, err := connector.NewManagerProxyClient(daemon.GetUserClient(ctx).Conn).Tunnel(ctx)
+	if err != nil {
+		return "", fmt.Errorf("unable to open tunnel: %w", err)
+	}
+	id := tunnel.NewConnID(ipproto.TCP, net.IP{127, 0, 0, 1}, podIP, 0, uint16(ci.ManagerPodPort))
+	tc := client.GetConfig(ctx).Timeouts
+	start := time.Now()
+	s, err := tunnel.NewClientStream(ctx, ms, id, daemon.GetSession(ctx).Info.SessionInfo.SessionId,
+		tc.Get(client.TimeoutRoundtripLatency), tc.Get(client.TimeoutEndpointDial))
+	if err != nil {
+		return "", fmt.Errorf("tunnel handshake failed: %w", err)
+	}
+	defer func() {
+		_ = s.Send(ctx, tunnel.NewMessage(tunnel.Disconnect, nil))
+		_ = s.CloseSend(ctx)
+	}()
+	rtt := time.Since(start)
+	var m tunnel.Message
+	for {
+		if m, err = s.Receive(ctx); err != nil {
+			return "", fmt.Errorf("no reply to dial through tunnel: %w", err)
+		}
+		if m.Code() != tunnel.KeepAlive {
+			break
+		}
+	}
+	if m.Code() != tunnel.DialOK {
+		return "", fmt.Errorf("dial of %s through tunnel was rejected", id.DestinationAddr())
+	}
+	return fmt.Sprintf("round trip in %s, dial through tunnel in %s", rtt.Round(time.Millisecond), time.Since(start).Round(time.Millisecond)), nil
+}
+
+func (dc *doctorCommand) checkNetworkDevice(cmd *cobra.Command) (string, error) {
+	ctx, cancel := context.WithTimeout(cmd.Context(), dc.timeout)
+	defer cancel()
+	userD := daemon.GetUserClient(ctx)
+	if dc.remote = userD.Remote; dc.remote {
+		return dc.skipRemote()
+	}
+	st, err := userD.Status(ctx, &emptypb.Empty{})
+	if err != nil {
+		return "", err
+	}
+	if st.DaemonStatus == nil || st.DaemonStatus.OutboundConfig == nil {
+		return "", errors.New("the root daemon is not connected")
+	}
+	cs, err := userD.GetClusterSubnets(ctx, &emptypb.Empty{})
+	if err != nil {
+		return "", err
+	}
+	for _, sn := range append(cs.PodSubnets, cs.SvcSubnets...) {
+		dc.subnets = append(dc.subnets, iputil.IPNetFromRPC(sn))
+	}
+	if len(dc.subnets) == 0 {
+		return "", errors.New("the traffic-manager didn't report any cluster subnets")
+	}
+	rt, err := routing.GetRoute(ctx, dc.subnets[0])
+	if err != nil {
+		return "", fmt.Errorf("no route to cluster subnet %s: %w", dc.subnets[0], err)
+	}
+	dc.tun = rt.Interface
+	if dc.tun == nil || dc.tun.Flags&net.FlagUp == 0 {
+		return "", fmt.Errorf("the network device that routes cluster subnet %s is not up", dc.subnets[0])
+	}
+	return fmt.Sprintf("device %s routes the cluster subnets", dc.tun.Name), nil
+}
+
+func (dc *doctorCommand) checkRouting(cmd *cobra.Command) (string, error) {
+	if dc.remote {
+		return dc.skipRemote()
+	}
+	ctx, cancel := context.WithTimeout(cmd.Context(), dc.timeout)
+	defer cancel()
+	table, err := routing.GetRoutingTable(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get routing table: %w", err)
+	}
+	if conflicts := routingConflicts(table, dc.tun, dc.subnets); len(conflicts) > 0 {
+		return "", fmt.Errorf("cluster subnets conflict with other routes: %s", strings.Join(conflicts, "; "))
+	}
+	return fmt.Sprintf("no conflicts between %d cluster subnets and %d routes", len(dc.subnets), len(table)), nil
+}
+
+// routingConflicts returns a description of each route that doesn't use the given device and overlaps
+// with one of the given subnets. Default routes are ignored, because they never take precedence over
+// the routes to the subnets.
+func routingConflicts(table []*routing.Route, dev *net.Interface, subnets []*net.IPNet) []string {
+	var conflicts []string
+	for _, sn := range subnets {
+		for _, rt := range table {
+			if rt.Default || rt.RoutedNet == nil || rt.RoutedNet.IP.IsUnspecified() {
+				continue
+			}
+			if rt.Interface != nil && dev != nil && rt.Interface.Index == dev.Index {
+				continue
+			}
+			if rt.Routes(sn.IP) || sn.Contains(rt.RoutedNet.IP) {
+				ifName := "unknown device"
+				if rt.Interface != nil {
+					ifName = rt.Interface.Name
+				}
+				conflicts = append(conflicts, fmt.Sprintf("subnet %s overlaps %s routed via %s", sn, rt.RoutedNet, ifName))
+			}
+		}
+	}
+	return conflicts
+}
+
+// checkDNSRecursion asks the root daemon to resolve the DNS check service using its DNS server, and
+// reports the outcome of the DNS server's check for queries that the cluster's DNS sends back to it.
+func (dc *doctorCommand) checkDNSRecursion(cmd *cobra.Command) (string, error) {
+	if dc.remote {
+		return dc.skipRemote()
+	}
+	ctx, cancel := context.WithTimeout(cmd.Context(), dc.timeout)
+	defer cancel()
+	conn, err := socket.Dial(ctx, socket.DaemonName)
+	if err != nil {
+		return "", fmt.Errorf("unable to connect to the root daemon: %w", err)
+	}
+	defer conn.Close()
+	if dc.dnsCheck, err = rootdRpc.NewDaemonClient(conn).CheckDNS(ctx, &rootdRpc.CheckDNSRequest{ServiceName: dnsCheckService}); err != nil {
+		return "", fmt.Errorf("unable to check the DNS server of the root daemon: %w", err)
+	}
+	switch dc.dnsCheck.Recursion {
+	case rootdRpc.CheckDNSResponse_NOT_DETECTED:
+		return "queries sent to the cluster don't recurse back to the DNS server", nil
+	case rootdRpc.CheckDNSResponse_DETECTED:
+		return "queries sent to the cluster recurse back to the DNS server, and are answered as not found when they do", nil
+	default:
+		return "", errors.New("the recursion check of the DNS server hasn't completed. The DNS isn't working properly")
+	}
+}
+
+func (dc *doctorCommand) checkDNS(_ *cobra.Command) (string, error) {
+	if dc.remote {
+		return dc.skipRemote()
+	}
+	r := dc.dnsCheck
+	if r.Error != "" {
+		return "", fmt.Errorf("unable to resolve %s: %s", r.Name, r.Error)
+	}
+	if len(r.Ips) == 0 {
+		return "", fmt.Errorf("%s resolves to no addresses", r.Name)
+	}
+	addrs := make([]string, len(r.Ips))
+	for i, ip := range r.Ips {
+		addrs[i] = net.IP(ip).String()
+	}
+	return fmt.Sprintf("%s resolves to %s", r.Name, strings.Join(addrs, ",")), nil
+}
+
+func (dc *doctorCommand) checkPodDial(cmd *cobra.Command) (string, error) {
+	return dc.dial(cmd.Context(), "traffic-manager pod", dc.clusterInfo.ManagerPodIp, dc.clusterInfo.ManagerPodPort)
+}
+
+func (dc *doctorCommand) checkServiceDial(cmd *cobra.Command) (string, error) {
+	return dc.dial(cmd.Context(), "agent-injector service", dc.clusterInfo.InjectorSvcIp, dc.clusterInfo.InjectorSvcPort)
+}
+
+func (dc *doctorCommand) dial(ctx context.Context, what string, ip net.IP, port int32) (string, error) {
+	if dc.remote {
+		return dc.skipRemote()
+	}
+	if ip == nil || port == 0 {
+		return fmt.Sprintf("the traffic-manager didn't report the %s address", what), errSkipCheck
+	}
+	addr := net.JoinHostPort(ip.String(), strconv.Itoa(int(port)))
+	d := net.Dialer{Timeout: dc.timeout}
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return "", fmt.Errorf("unable to dial %s at %s: %w", what, addr, err)
+	}
+	_ = conn.Close()
+	return fmt.Sprintf("dialed %s at %s", what, addr), nil
+}
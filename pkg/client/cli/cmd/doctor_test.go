@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"bytes"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/telepresenceio/telepresence/v2/pkg/vif/routing"
+)
+
+func Test_routingConflicts(t *testing.T) {
+	cidr := func(s string) *net.IPNet {
+		_, n, err := net.ParseCIDR(s)
+		require.NoError(t, err)
+		return n
+	}
+	tun := &net.Interface{Index: 7, Name: "tel0"}
+	eth := &net.Interface{Index: 2, Name: "eth0"}
+	vpn := &net.Interface{Index: 3, Name: "vpn0"}
+	table := []*routing.Route{
+		{RoutedNet: cidr("0.0.0.0/0"), Interface: eth, Default: true},
+		{RoutedNet: cidr("192.168.1.0/24"), Interface: eth},
+		{RoutedNet: cidr("10.96.0.0/12"), Interface: tun},
+		{RoutedNet: cidr("10.244.0.0/16"), Interface: tun},
+		{RoutedNet: cidr("10.244.128.0/17"), Interface: vpn},
+	}
+	subnets := []*net.IPNet{cidr("10.96.0.0/12"), cidr("10.244.0.0/16")}
+
+	assert.Equal(t,
+		[]string{"subnet 10.244.0.0/16 overlaps 10.244.128.0/17 routed via vpn0"},
+		routingConflicts(table, tun, subnets))
+	assert.Empty(t, routingConflicts(table[:4], tun, subnets))
+}
+
+func Test_doctorReport(t *testing.T) {
+	r := &doctorReport{Checks: []*doctorCheck{
+		{Name: "kubeconfig", Status: checkPass, Message: "ok"},
+		{Name: "traffic-manager", Status: checkFail, Message: "down"},
+		{Name: "tunnel", Status: checkSkip, Message: "skipped"},
+	}}
+	assert.True(t, r.failed())
+	buf := bytes.Buffer{}
+	_, err := r.WriteTo(&buf)
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), bad+" traffic-manager  down\n")
+
+	r.Checks[1].Status = checkPass
+	assert.False(t, r.failed())
+}
@@ -11,10 +11,12 @@ import (
 	apps "k8s.io/api/apps/v1"
 	core "k8s.io/api/core/v1"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/yaml"
 
@@ -25,6 +27,7 @@ import (
 	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/flags"
 	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
 	"github.com/telepresenceio/telepresence/v2/pkg/tracing"
+	"github.com/telepresenceio/telepresence/v2/pkg/workload"
 )
 
 type genYAMLCommand struct {
@@ -144,26 +147,35 @@ func (i *genYAMLCommand) loadWorkload(ctx context.Context) (k8sapi.Workload, err
 	}
 
 	scheme := runtime.NewScheme()
-	scheme.AddKnownTypes(schema.GroupVersion{Group: apps.GroupName, Version: "v1"}, &apps.StatefulSet{}, &apps.Deployment{}, &apps.ReplicaSet{})
+	scheme.AddKnownTypes(schema.GroupVersion{Group: apps.GroupName, Version: "v1"}, &apps.StatefulSet{}, &apps.Deployment{}, &apps.ReplicaSet{}, &apps.DaemonSet{})
+	scheme.AddKnownTypes(schema.GroupVersion{Group: core.GroupName, Version: "v1"}, &core.Pod{})
 	codecFactory := serializer.NewCodecFactory(scheme)
 	deserializer := codecFactory.UniversalDeserializer()
 
 	obj, kind, err := deserializer.Decode(b, nil, nil)
 	if err != nil {
-		return nil, errcat.User.Newf("unable to parse yaml in %s: %w", i.inputFile, err)
+		if !runtime.IsNotRegisteredError(err) {
+			return nil, errcat.User.Newf("unable to parse yaml in %s: %w", i.inputFile, err)
+		}
+		// Might be an Argo Rollout, which is represented as an unstructured object.
+		u := &unstructured.Unstructured{}
+		js, jsErr := yaml.YAMLToJSON(b)
+		if jsErr == nil {
+			jsErr = u.UnmarshalJSON(js)
+		}
+		if jsErr != nil {
+			return nil, errcat.User.Newf("unable to parse yaml in %s: %w", i.inputFile, jsErr)
+		}
+		obj = u
+		gvk := u.GroupVersionKind()
+		kind = &gvk
 	}
-	wl, err := k8sapi.WrapWorkload(obj)
+	wl, err := workload.WrapWorkload(obj)
 	if err != nil {
-		return nil, errcat.User.Newf("unexpected object of kind %s; please pass in a Deployment, ReplicaSet, or StatefulSet", kind)
+		return nil, errcat.User.Newf("unexpected object of kind %s; please pass in a Deployment, ReplicaSet, StatefulSet, DaemonSet, Rollout, or Pod", kind)
 	}
 	if wl.GetNamespace() == "" {
-		if d, ok := k8sapi.DeploymentImpl(wl); ok {
-			d.Namespace = i.namespace
-		} else if r, ok := k8sapi.ReplicaSetImpl(wl); ok {
-			r.Namespace = i.namespace
-		} else if s, ok := k8sapi.StatefulSetImpl(wl); ok {
-			s.Namespace = i.namespace
-		}
+		wl.SetNamespace(i.namespace)
 	}
 	return wl, nil
 }
@@ -226,10 +238,15 @@ func (i *genYAMLCommand) withK8sInterface(ctx context.Context, flagMap map[strin
 		}
 	}
 	cs, err := kubernetes.NewForConfig(restConfig)
-	if err == nil {
-		ctx = k8sapi.WithK8sInterface(ctx, cs)
+	if err != nil {
+		return nil, err
+	}
+	ctx = k8sapi.WithK8sInterface(ctx, cs)
+	di, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
 	}
-	return ctx, err
+	return workload.WithDynamicInterface(ctx, di), nil
 }
 
 type genConfigMap struct {
@@ -258,7 +275,7 @@ func genConfigMapSubCommand(yamlInfo *genYAMLCommand) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	flags.StringVarP(&info.inputFile, "input", "i", "",
-		"Path to the yaml containing the workload definition (i.e. Deployment, StatefulSet, etc). Pass '-' for stdin.. Mutually exclusive to --workload")
+		"Path to the yaml containing the workload definition (i.e. Deployment, StatefulSet, DaemonSet, Rollout, etc). Pass '-' for stdin.. Mutually exclusive to --workload")
 	flags.StringVarP(&info.workloadName, "workload", "w", "",
 		"Name of the workload. If given, the workload will be retrieved from the cluster, mutually exclusive to --input")
 	flags.Uint16Var(&info.AgentPort, "agent-port", 9900,
@@ -28,6 +28,7 @@ func interceptCmd() *cobra.Command {
 		PostRunE:          cloud.RaiseMessage,
 	}
 	ic.AddFlags(cmd.Flags())
+	cmd.AddCommand(interceptPause(), interceptResume())
 	if err := cmd.RegisterFlagCompletionFunc("namespace", ic.AutocompleteNamespace); err != nil {
 		log.Fatal(err)
 	}
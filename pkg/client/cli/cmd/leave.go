@@ -3,8 +3,10 @@ package cmd
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/datawire/dlib/dcontext"
 	"github.com/telepresenceio/telepresence/rpc/v2/connector"
@@ -13,10 +15,12 @@ import (
 	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/connect"
 	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/daemon"
 	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/intercept"
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
 )
 
 func leave() *cobra.Command {
-	return &cobra.Command{
+	var drain time.Duration
+	cmd := &cobra.Command{
 		Use:  "leave [flags] <intercept_name>",
 		Args: cobra.ExactArgs(1),
 
@@ -28,41 +32,61 @@ func leave() *cobra.Command {
 			if err := connect.InitCommand(cmd); err != nil {
 				return err
 			}
-			return removeIntercept(cmd.Context(), strings.TrimSpace(args[0]))
-		},
-		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-			shellCompDir := cobra.ShellCompDirectiveNoFileComp
-			if len(args) != 0 {
-				return nil, shellCompDir
-			}
-			if err := connect.InitCommand(cmd); err != nil {
-				return nil, shellCompDir | cobra.ShellCompDirectiveError
-			}
-			ctx := cmd.Context()
-			userD := daemon.GetUserClient(ctx)
-			resp, err := userD.List(ctx, &connector.ListRequest{Filter: connector.ListRequest_INTERCEPTS})
-			if err != nil {
-				return nil, shellCompDir | cobra.ShellCompDirectiveError
-			}
-			if len(resp.Workloads) == 0 {
-				return nil, shellCompDir
+			if drain < 0 {
+				return errcat.User.New("the --drain timeout cannot be negative")
 			}
+			return drainIntercept(cmd.Context(), strings.TrimSpace(args[0]), drain)
+		},
+		ValidArgsFunction: validInterceptNames,
+	}
+	cmd.Flags().DurationVar(&drain, "drain", 0,
+		"Send new connections to the intercepted container and wait this long for the connections that are tunneled to "+
+			"the intercept to close before the intercept is removed")
+	return cmd
+}
+
+// validInterceptNames completes the name of an existing intercept.
+func validInterceptNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	shellCompDir := cobra.ShellCompDirectiveNoFileComp
+	if len(args) != 0 {
+		return nil, shellCompDir
+	}
+	if err := connect.InitCommand(cmd); err != nil {
+		return nil, shellCompDir | cobra.ShellCompDirectiveError
+	}
+	ctx := cmd.Context()
+	userD := daemon.GetUserClient(ctx)
+	resp, err := userD.List(ctx, &connector.ListRequest{Filter: connector.ListRequest_INTERCEPTS})
+	if err != nil {
+		return nil, shellCompDir | cobra.ShellCompDirectiveError
+	}
+	if len(resp.Workloads) == 0 {
+		return nil, shellCompDir
+	}
 
-			var completions []string
-			for _, intercept := range resp.Workloads {
-				for _, ii := range intercept.InterceptInfos {
-					name := ii.Spec.Name
-					if strings.HasPrefix(name, toComplete) {
-						completions = append(completions, name)
-					}
-				}
+	var completions []string
+	for _, intercept := range resp.Workloads {
+		for _, ii := range intercept.InterceptInfos {
+			name := ii.Spec.Name
+			if strings.HasPrefix(name, toComplete) {
+				completions = append(completions, name)
 			}
-			return completions, shellCompDir
-		},
+		}
 	}
+	return completions, shellCompDir
 }
 
 func removeIntercept(ctx context.Context, name string) error {
+	return drainIntercept(ctx, name, 0)
+}
+
+// drainIntercept removes the intercept with the given name once the connections that are tunneled to
+// it have closed, or when the given timeout has passed. A zero timeout removes the intercept at once.
+func drainIntercept(ctx context.Context, name string, timeout time.Duration) error {
 	userD := daemon.GetUserClient(ctx)
-	return intercept.Result(userD.RemoveIntercept(dcontext.WithoutCancel(ctx), &manager.RemoveInterceptRequest2{Name: name}))
+	rq := &manager.RemoveInterceptRequest2{Name: name}
+	if timeout > 0 {
+		rq.DrainTimeout = durationpb.New(timeout)
+	}
+	return intercept.Result(userD.RemoveIntercept(dcontext.WithoutCancel(ctx), rq))
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
@@ -146,7 +147,7 @@ func (s *listCommand) printList(ctx context.Context, workloads []*connector.Work
 		if formattedOut {
 			output.Object(ctx, []struct{}{}, false)
 		} else {
-			fmt.Fprintln(stdout, "No Workloads (Deployments, StatefulSets, or ReplicaSets)")
+			fmt.Fprintln(stdout, "No Workloads (Deployments, StatefulSets, ReplicaSets, DaemonSets, Rollouts, or Pods)")
 		}
 		return
 	}
@@ -157,6 +158,13 @@ func (s *listCommand) printList(ctx context.Context, workloads []*connector.Work
 		}
 		ai := workload.AgentInfo
 		if ai != nil {
+			if ir := ai.IdleRemoval; ir != nil {
+				left := time.Until(ir.AsTime()).Round(time.Second)
+				if left < 0 {
+					left = 0
+				}
+				return fmt.Sprintf("ready to intercept (traffic-agent already installed, removed in %s unless intercepted)", left)
+			}
 			return "ready to intercept (traffic-agent already installed)"
 		}
 		if workload.NotInterceptableReason != "" {
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/ann"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/connect"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/daemon"
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+)
+
+func interceptPause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "pause [flags] <intercept_name>",
+		Args: reservedNameArgs,
+
+		Short: "Temporarily send the traffic of an intercept to the intercepted container",
+		Long: "Temporarily send the traffic of an intercept to the intercepted container. The intercept, " +
+			"its volume mounts and its port-forwards remain in place until it is resumed or removed.",
+		Annotations: map[string]string{
+			ann.Session: ann.Required,
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return pauseIntercept(cmd, strings.TrimSpace(args[0]), true)
+		},
+		ValidArgsFunction: validInterceptNames,
+	}
+	cmd.SetFlagErrorFunc(reservedNameError)
+	return cmd
+}
+
+func interceptResume() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "resume [flags] <intercept_name>",
+		Args: reservedNameArgs,
+
+		Short: "Send the traffic of a paused intercept to the client again",
+		Annotations: map[string]string{
+			ann.Session: ann.Required,
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return pauseIntercept(cmd, strings.TrimSpace(args[0]), false)
+		},
+		ValidArgsFunction: validInterceptNames,
+	}
+	cmd.SetFlagErrorFunc(reservedNameError)
+	return cmd
+}
+
+// reservedNameArgs requires exactly one intercept name. The pause and resume subcommands take precedence over
+// intercept names, so other arguments are likely an attempt to create an intercept named "pause" or "resume".
+func reservedNameArgs(cmd *cobra.Command, args []string) error {
+	if err := cobra.ExactArgs(1)(cmd, args); err != nil {
+		return reservedNameError(cmd, err)
+	}
+	return nil
+}
+
+// reservedNameError explains that the name of the given subcommand can't be used as the name of an intercept.
+func reservedNameError(cmd *cobra.Command, err error) error {
+	name := cmd.Name()
+	return errcat.User.Newf("%v\n%q is reserved by \"telepresence intercept %s <intercept_name>\". "+
+		"Use \"telepresence intercept <intercept_name> --workload %s\" to intercept a workload named %q",
+		err, name, name, name, name)
+}
+
+func pauseIntercept(cmd *cobra.Command, name string, pause bool) error {
+	if err := connect.InitCommand(cmd); err != nil {
+		return err
+	}
+	rq := &manager.UpdateInterceptRequest{Name: name}
+	if pause {
+		rq.PauseAction = &manager.UpdateInterceptRequest_Pause{Pause: true}
+	} else {
+		rq.PauseAction = &manager.UpdateInterceptRequest_Resume{Resume: true}
+	}
+	ii, err := daemon.GetUserClient(cmd.Context()).UpdateIntercept(cmd.Context(), rq)
+	if err != nil {
+		return updateInterceptError(err)
+	}
+	if pause {
+		fmt.Fprintf(cmd.OutOrStdout(), "Intercept %s paused. Its traffic is sent to the intercepted container\n", ii.Spec.Name)
+	} else {
+		fmt.Fprintf(cmd.OutOrStdout(), "Intercept %s resumed\n", ii.Spec.Name)
+	}
+	return nil
+}
+
+// updateInterceptError returns an error in the user category when the traffic-manager
+// rejected the update because of the intercept's state.
+func updateInterceptError(err error) error {
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.NotFound, codes.FailedPrecondition:
+			return errcat.User.New(st.Message())
+		}
+	}
+	return err
+}
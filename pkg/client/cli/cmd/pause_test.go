@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+)
+
+func TestInterceptPause_reservedName(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{name: "no intercept name", args: []string{"pause"}},
+		{name: "intercept flags", args: []string{"pause", "--port", "8080"}},
+		{name: "resume with intercept flags", args: []string{"resume", "--port", "8080"}},
+		{name: "too many names", args: []string{"resume", "echo", "hello"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := interceptCmd()
+			cmd.SetArgs(tt.args)
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+			err := cmd.Execute()
+			require.Error(t, err)
+			assert.Equal(t, errcat.User, errcat.GetCategory(err))
+			assert.Contains(t, err.Error(), `"`+tt.args[0]+`" is reserved`)
+			assert.Contains(t, err.Error(), "--workload "+tt.args[0])
+		})
+	}
+}
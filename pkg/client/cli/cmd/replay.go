@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+	"github.com/telepresenceio/telepresence/v2/pkg/recording"
+)
+
+type replayCommand struct {
+	to    string
+	speed float64
+}
+
+func replay() *cobra.Command {
+	rc := &replayCommand{}
+	cmd := &cobra.Command{
+		Use:  "replay <dir|file>",
+		Args: cobra.ExactArgs(1),
+
+		Short: "Replay recorded intercept traffic",
+		Long: `Replay the connections that were recorded using "telepresence intercept --record <dir>".
+
+Each recorded connection is replayed in the order that it was established. The data that was sent
+to the intercepted workload is sent again, and the number of bytes that the target responds with is
+reported together with the number of bytes that were recorded.`,
+		RunE:          rc.run,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+	flags := cmd.Flags()
+	flags.StringVar(&rc.to, "to", "",
+		`The address to send the traffic to, e.g. localhost:8080. Defaults to the recorded intercept target`)
+	flags.Float64Var(&rc.speed, "speed", 1.0,
+		`Replay speed relative to the recording. Use 0 to send all data without delays`)
+	return cmd
+}
+
+func (rc *replayCommand) run(cmd *cobra.Command, args []string) error {
+	if rc.speed < 0 {
+		return errcat.User.New("--speed cannot be negative")
+	}
+	path := args[0]
+	st, err := os.Stat(path)
+	if err != nil {
+		return errcat.User.New(err)
+	}
+	files := []string{path}
+	if st.IsDir() {
+		if files, err = recording.Files(path); err != nil {
+			return err
+		}
+		if len(files) == 0 {
+			return errcat.User.Newf("no recordings found in %s", path)
+		}
+	}
+
+	ctx := cmd.Context()
+	out := cmd.OutOrStdout()
+	for _, file := range files {
+		result, err := recording.Replay(ctx, file, rc.to, rc.speed)
+		if err != nil {
+			return fmt.Errorf("replay of %s failed: %w", file, err)
+		}
+		fmt.Fprintf(out, "%s: sent %d bytes, received %d bytes (%d recorded)\n",
+			filepath.Base(file), result.Sent, result.Received, result.Recorded)
+	}
+	return nil
+}
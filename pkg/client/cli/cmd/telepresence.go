@@ -133,8 +133,8 @@ func OnlySubcommands(cmd *cobra.Command, args []string) error {
 
 func WithSubCommands(ctx context.Context) context.Context {
 	return MergeSubCommands(ctx,
-		config(), connectCmd(), currentClusterId(), gatherLogs(), gatherTraces(), genYAML(), helm(), interceptCmd(), leave(),
-		list(), loglevel(), quit(), statusCmd(), testVPN(), uninstall(), uploadTraces(), version(),
+		config(), connectCmd(), connections(), currentClusterId(), doctor(), down(), gatherLogs(), gatherTraces(), genYAML(), helm(), interceptCmd(), leave(),
+		list(), loglevel(), quit(), replay(), statusCmd(), testVPN(), uninstall(), up(), uploadTraces(), version(),
 	)
 }
 
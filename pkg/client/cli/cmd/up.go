@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/cobra"
+
+	"github.com/datawire/dlib/dcontext"
+	"github.com/datawire/dlib/dgroup"
+	"github.com/telepresenceio/telepresence/rpc/v2/common"
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/ann"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/connect"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/daemon"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/intercept"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/profile"
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+)
+
+type upCommand struct {
+	file    string
+	request *daemon.Request
+}
+
+func up() *cobra.Command {
+	uc := &upCommand{}
+	cmd := &cobra.Command{
+		Use:  "up [flags] [<profile>]",
+		Args: cobra.MaximumNArgs(1),
+
+		Short: "Connect and create the intercepts of a profile",
+		Long: `Connect to the cluster using the connect settings of a profile in the project file, and then create
+all intercepts that the profile declares. The profile can be omitted when the file declares only one profile.
+
+Flags given on the command line take precedence over the connect settings of the profile. Intercepts that run a
+command or a docker container are left when the command ends. All other intercepts remain until they are removed
+using "telepresence down".`,
+		Annotations: map[string]string{
+			ann.Session: ann.Required,
+		},
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE:          uc.run,
+	}
+	uc.request = daemon.InitRequest(cmd)
+	cmd.Flags().StringVarP(&uc.file, "file", "f", profile.DefaultFile, "The project file that declares the profiles")
+	return cmd
+}
+
+func down() *cobra.Command {
+	var file string
+	cmd := &cobra.Command{
+		Use:  "down [flags] [<profile>]",
+		Args: cobra.MaximumNArgs(1),
+
+		Short: "Remove the intercepts of a profile and disconnect",
+		Long: `Remove all intercepts that are declared by a profile in the project file, and then disconnect from the
+cluster. The profile can be omitted when the file declares only one profile.`,
+		Annotations: map[string]string{
+			ann.Session: ann.Optional,
+		},
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDown(cmd, file, args)
+		},
+	}
+	cmd.Flags().StringVarP(&file, "file", "f", profile.DefaultFile, "The project file that declares the profiles")
+	return cmd
+}
+
+// profileIntercept is an intercept of a profile, parsed and validated by the intercept command.
+type profileIntercept struct {
+	cmd *cobra.Command
+	ic  *intercept.Command
+}
+
+// loadProfile loads the selected profile from the project file and validates its intercepts.
+func loadProfile(cmd *cobra.Command, file string, args []string) (string, *profile.Profile, []profileIntercept, error) {
+	f, err := profile.Load(file)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	var name string
+	if len(args) > 0 {
+		name = args[0]
+	}
+	name, p, err := f.Select(name)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	pis := make([]profileIntercept, len(p.Intercepts))
+	for i, pic := range p.Intercepts {
+		if pis[i].cmd, pis[i].ic, err = pic.Command(cmd.Context(), cmd); err != nil {
+			return "", nil, nil, err
+		}
+	}
+	return name, p, pis, nil
+}
+
+func (uc *upCommand) run(cmd *cobra.Command, args []string) error {
+	name, p, pis, err := loadProfile(cmd, uc.file, args)
+	if err != nil {
+		return err
+	}
+
+	// Apply the connect settings of the profile, unless they were overridden using flags.
+	for fn, v := range p.Connect.Flags() {
+		flag := cmd.Flag(fn)
+		if flag == nil || flag.Changed {
+			continue
+		}
+		if err = flag.Value.Set(v); err != nil {
+			return errcat.User.Newf("profile %q: invalid connect setting %s: %w", name, fn, err)
+		}
+		flag.Changed = true
+	}
+	uc.request.CommitFlags(cmd)
+	if err = connect.InitCommand(cmd); err != nil {
+		return err
+	}
+	ctx := cmd.Context()
+
+	var runners []intercept.State
+	for _, pi := range pis {
+		pi.cmd.SetContext(ctx)
+		st := intercept.NewState(pi.cmd, pi.ic)
+		if st.RunAndLeave() {
+			runners = append(runners, st)
+			continue
+		}
+		if err = intercept.Run(ctx, st); err != nil {
+			return err
+		}
+	}
+	if len(runners) == 0 {
+		return nil
+	}
+
+	// Run the intercepts that run commands concurrently, and wait for all of them to end.
+	g := dgroup.NewGroup(ctx, dgroup.GroupConfig{})
+	for _, st := range runners {
+		st := st
+		g.Go(st.Name(), func(ctx context.Context) error {
+			return intercept.Run(ctx, st)
+		})
+	}
+	return g.Wait()
+}
+
+func runDown(cmd *cobra.Command, file string, args []string) error {
+	_, _, pis, err := loadProfile(cmd, file, args)
+	if err != nil {
+		return err
+	}
+	if err = connect.InitCommand(cmd); err != nil {
+		return err
+	}
+	ctx := cmd.Context()
+	var result error
+	if daemon.GetSession(ctx) != nil {
+		// Intercepts that were never created, or that have been left already, are not errors. A failure
+		// to remove one intercept must not prevent the removal of the others, nor the disconnect.
+		userD := daemon.GetUserClient(ctx)
+		for _, pi := range pis {
+			r, err := userD.RemoveIntercept(dcontext.WithoutCancel(ctx), &manager.RemoveInterceptRequest2{Name: pi.ic.Name})
+			if err == nil && r.Error == common.InterceptError_NOT_FOUND {
+				continue
+			}
+			if err = intercept.Result(r, err); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+	if err = connect.Disconnect(ctx, false); err != nil {
+		result = multierror.Append(result, err)
+	}
+	return result
+}
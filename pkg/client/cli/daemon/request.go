@@ -45,6 +45,9 @@ func InitRequest(cmd *cobra.Command) *Request {
 			`Comma separated list of CIDR to never proxy`)
 	nwFlags.StringVar(&cr.ManagerNamespace, "manager-namespace", "", `The namespace where the traffic manager is to be found. `+
 		`Overrides any other manager namespace set in config`)
+	nwFlags.StringVar(&cr.EgressVia, "egress-via", "", ``+
+		`Route all outbound connections through the traffic-agent of the given workload, so that they appear to `+
+		`originate from that workload. Use <name>.<namespace> for a workload outside of the default namespace`)
 	flags.AddFlagSet(nwFlags)
 
 	kubeConfig := genericclioptions.NewConfigFlags(false)
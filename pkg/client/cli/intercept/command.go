@@ -3,8 +3,11 @@ package intercept
 import (
 	"context"
 	"os"
+	"path"
+	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -14,6 +17,7 @@ import (
 	"github.com/telepresenceio/telepresence/v2/pkg/client"
 	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/connect"
 	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/daemon"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/remotefs"
 	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
 )
 
@@ -25,6 +29,7 @@ type Command struct {
 	ServiceName    string // --service // only valid if !localOnly
 	LocalOnly      bool   // --local-only
 	LocalMountPort uint16 // --local-mount-port
+	RecordDir      string // --record
 
 	EnvFile  string   // --env-file
 	EnvJSON  string   // --env-json
@@ -32,14 +37,36 @@ type Command struct {
 	MountSet bool     // whether --mount was passed
 	ToPod    []string // --to-pod
 
+	MountMode    string   // --mount-mode "mount" or "sync"
+	SyncInclude  []string // --sync-include
+	SyncExclude  []string // --sync-exclude
+	SyncConflict string   // --sync-conflict
+
 	DockerRun   bool     // --docker-run
 	DockerMount string   // --docker-mount // where to mount in a docker container. Defaults to mount unless mount is "true" or "false".
 	Cmdline     []string // Command[1:]
 
-	Mechanism      string // --mechanism tcp
-	MechanismArgs  []string
+	Mechanism      string   // --mechanism tcp
+	MechanismArgs  []string // derived from the --http-xxx flags
+	HTTPHeader     []string // --http-header KEY=VALUE
+	HTTPPathEqual  string   // --http-path-equal
+	HTTPPathPrefix string   // --http-path-prefix
+	HTTPPathRegex  string   // --http-path-regex
+	Mirror         bool     // --mirror
 	ExtendedInfo   []byte
 	DetailedOutput bool
+
+	TTL         time.Duration // --ttl
+	IdleTimeout time.Duration // --idle-timeout
+
+	Fallback        bool   // --fallback
+	HealthCheckPath string // --health-check-path
+
+	Pod      string // --pod
+	Replicas int    // --replicas
+
+	Sample      string  // --sample
+	SampleRatio float64 // derived from --sample
 }
 
 func (a *Command) AddFlags(flags *pflag.FlagSet) {
@@ -65,6 +92,22 @@ func (a *Command) AddFlags(flags *pflag.FlagSet) {
 		`The absolute path for the root directory where volumes will be mounted, $TELEPRESENCE_ROOT. Use "true" to `+
 		`have Telepresence pick a random mount point (default). Use "false" to disable filesystem mounting entirely.`)
 
+	flags.StringVar(&a.MountMode, "mount-mode", "mount", ``+
+		`Use "mount" to mount the remote volumes at the mount point, or "sync" to keep a local copy of them `+
+		`at the mount point that is synchronized in both directions`)
+
+	flags.StringSliceVar(&a.SyncInclude, "sync-include", nil, ``+
+		`Only synchronize files matching one of these globs. A glob is matched against the file name and `+
+		`against the path relative to its volume. Requires --mount-mode=sync`)
+
+	flags.StringSliceVar(&a.SyncExclude, "sync-exclude", nil, ``+
+		`Don't synchronize files or directories matching any of these globs, e.g. "node_modules" or "*.log". `+
+		`Requires --mount-mode=sync`)
+
+	flags.StringVar(&a.SyncConflict, "sync-conflict", "", ``+
+		`How to resolve a file that has changed on both sides. Use "newer" (default) to keep the most recently `+
+		`modified file, or "local" or "remote" to always keep that side. Requires --mount-mode=sync`)
+
 	flags.StringSliceVar(&a.ToPod, "to-pod", []string{}, ``+
 		`An additional port to forward from the intercepted pod, will be made available at localhost:PORT `+
 		`Use this to, for example, access proxy/helper sidecars in the intercepted pod. The default protocol is TCP. `+
@@ -81,11 +124,57 @@ func (a *Command) AddFlags(flags *pflag.FlagSet) {
 
 	flags.StringVar(&a.Mechanism, "mechanism", "tcp", "Which extension `mechanism` to use")
 
+	flags.StringArrayVar(&a.HTTPHeader, "http-header", nil, ``+
+		`Only intercept HTTP requests with a header matching KEY=VALUE. The VALUE is treated as a regular expression `+
+		`if it contains regexp meta characters. Can be repeated. Implies --mechanism=http`)
+
+	flags.StringVar(&a.HTTPPathEqual, "http-path-equal", "", ``+
+		`Only intercept HTTP requests with a path equal to this value. Implies --mechanism=http`)
+
+	flags.StringVar(&a.HTTPPathPrefix, "http-path-prefix", "", ``+
+		`Only intercept HTTP requests with a path that starts with this value. Implies --mechanism=http`)
+
+	flags.StringVar(&a.HTTPPathRegex, "http-path-regex", "", ``+
+		`Only intercept HTTP requests with a path that matches this regular expression. Implies --mechanism=http`)
+
+	flags.BoolVar(&a.Mirror, "mirror", false, ``+
+		`Send a copy of the traffic to the workstation without diverting it. The intercepted container continues to `+
+		`serve all requests and the replies from the workstation are discarded`)
+
+	flags.DurationVar(&a.TTL, "ttl", 0, ``+
+		`Remove the intercept when this time has passed. The traffic-manager may impose a shorter time-to-live`)
+
+	flags.DurationVar(&a.IdleTimeout, "idle-timeout", 0, ``+
+		`Remove the intercept when no traffic has been routed to the workstation during this time`)
+
+	flags.BoolVar(&a.Fallback, "fallback", false, ``+
+		`Send connections to the intercepted container while the local port is unreachable, e.g. because the `+
+		`local process has crashed or hasn't started yet`)
+
+	flags.StringVar(&a.HealthCheckPath, "health-check-path", "", ``+
+		`An HTTP path that the traffic-agent periodically requests from the local port to check that it's healthy. `+
+		`Implies --fallback`)
+
+	flags.StringVar(&a.Pod, "pod", "", ``+
+		`Intercept only the traffic of this pod of the workload. The other replicas keep serving their traffic`)
+
+	flags.IntVar(&a.Replicas, "replicas", 0, ``+
+		`Intercept only the traffic of this number of the workload's replicas. The other replicas keep serving `+
+		`their traffic`)
+
+	flags.StringVar(&a.Sample, "sample", "", ``+
+		`Intercept only this percentage of the new connections, e.g. "10%". HTTP intercepts sample requests `+
+		`instead of connections. The other connections are served by the intercepted container`)
+
 	flags.BoolVarP(&a.DetailedOutput, "detailed-output", "", false,
 		`Provide very detailed info about the intercept when used together with --output=json or --output=yaml'`)
 
 	flags.Uint16Var(&a.LocalMountPort, "local-mount-port", 0,
 		`Do not mount remote directories. Instead, expose this port on localhost to an external mounter`)
+
+	flags.StringVar(&a.RecordDir, "record", "", ``+
+		`Record the traffic of each intercepted connection to a file in this directory. `+
+		`The recordings can be replayed using "telepresence replay"`)
 }
 
 func (a *Command) Validate(cmd *cobra.Command, positional []string) error {
@@ -105,14 +194,35 @@ func (a *Command) Validate(cmd *cobra.Command, positional []string) error {
 		if cmd.Flag("port").Changed {
 			return errcat.User.New("a local-only intercept cannot have a port")
 		}
-		if cmd.Flag("mount").Changed {
+		if cmd.Flag("mount").Changed || cmd.Flag("mount-mode").Changed {
 			return errcat.User.New("a local-only intercept cannot have mounts")
 		}
+		if a.RecordDir != "" {
+			return errcat.User.New("a local-only intercept cannot record traffic")
+		}
+		if a.Mirror {
+			return errcat.User.New("a local-only intercept cannot mirror traffic")
+		}
+		if a.TTL != 0 || a.IdleTimeout != 0 {
+			return errcat.User.New("a local-only intercept cannot expire")
+		}
+		if a.Fallback || a.HealthCheckPath != "" {
+			return errcat.User.New("a local-only intercept cannot fall back")
+		}
+		if a.Pod != "" || a.Replicas != 0 {
+			return errcat.User.New("a local-only intercept cannot select pods")
+		}
+		if a.Sample != "" {
+			return errcat.User.New("a local-only intercept cannot sample traffic")
+		}
 		return nil
 	}
 
-	if a.LocalMountPort > 0 && client.GetConfig(cmd.Context()).Intercept.UseFtp {
-		return errcat.User.New("only SFTP can be used with --local-mount-port. Client is configured to perform remote mounts using FTP")
+	if err := a.validateSyncFlags(); err != nil {
+		return err
+	}
+	if a.LocalMountPort > 0 && a.MountMode != "sync" && client.GetConfig(cmd.Context()).Intercept.UsesFuseFTP() {
+		return errcat.User.New("only SFTP can be used with --local-mount-port. Client is configured to perform remote mounts using FTP or gRPC")
 	}
 
 	// Actually intercepting something
@@ -125,7 +235,47 @@ func (a *Command) Validate(cmd *cobra.Command, positional []string) error {
 	if a.Port == "" {
 		a.Port = strconv.Itoa(client.GetConfig(cmd.Context()).Intercept.DefaultPort)
 	}
+	if _, _, _, err := parsePort(a.Port, a.DockerRun); err != nil {
+		return err
+	}
 	a.MountSet = cmd.Flag("mount").Changed
+	if a.RecordDir != "" {
+		// The directory is used by the user daemon, so it must be absolute
+		var err error
+		if a.RecordDir, err = filepath.Abs(a.RecordDir); err != nil {
+			return errcat.User.Newf("invalid --record directory: %w", err)
+		}
+	}
+	if err := a.validateHTTPFlags(cmd); err != nil {
+		return err
+	}
+	if a.Mirror && a.Mechanism != "tcp" {
+		return errcat.User.Newf("--mirror cannot be used with --mechanism=%s", a.Mechanism)
+	}
+	if a.HealthCheckPath != "" {
+		if !strings.HasPrefix(a.HealthCheckPath, "/") {
+			return errcat.User.Newf("invalid --health-check-path %q, the path must start with a slash", a.HealthCheckPath)
+		}
+		a.Fallback = true
+	}
+	if a.Mirror && a.Fallback {
+		return errcat.User.New("--fallback and --health-check-path cannot be used with --mirror")
+	}
+	if a.TTL < 0 {
+		return errcat.User.New("--ttl cannot be negative")
+	}
+	if a.IdleTimeout < 0 {
+		return errcat.User.New("--idle-timeout cannot be negative")
+	}
+	if a.Replicas < 0 {
+		return errcat.User.New("--replicas cannot be negative")
+	}
+	if a.Pod != "" && a.Replicas != 0 {
+		return errcat.User.New("--pod and --replicas are mutually exclusive")
+	}
+	if err := a.validateSample(); err != nil {
+		return err
+	}
 	if a.DockerRun {
 		if err := a.ValidateDockerArgs(); err != nil {
 			return err
@@ -134,6 +284,89 @@ func (a *Command) Validate(cmd *cobra.Command, positional []string) error {
 	return nil
 }
 
+// validateSyncFlags validates the --mount-mode and --sync-xxx flags.
+func (a *Command) validateSyncFlags() error {
+	switch a.MountMode {
+	case "", "mount":
+		if len(a.SyncInclude) > 0 || len(a.SyncExclude) > 0 || a.SyncConflict != "" {
+			return errcat.User.New("the --sync-xxx flags require --mount-mode=sync")
+		}
+		return nil
+	case "sync":
+	default:
+		return errcat.User.Newf("invalid --mount-mode %q, expected \"mount\" or \"sync\"", a.MountMode)
+	}
+	if a.LocalMountPort > 0 {
+		return errcat.User.New("--mount-mode=sync cannot be used with --local-mount-port")
+	}
+	if doMount, err := strconv.ParseBool(a.Mount); err == nil && !doMount {
+		return errcat.User.New("--mount-mode=sync cannot be used with --mount=false")
+	}
+	for _, g := range append(a.SyncInclude, a.SyncExclude...) {
+		if _, err := path.Match(g, ""); err != nil {
+			return errcat.User.Newf("invalid sync glob %q: %w", g, err)
+		}
+	}
+	if !remotefs.ValidConflictRule(a.SyncConflict) {
+		return errcat.User.Newf("invalid --sync-conflict %q, expected \"newer\", \"local\", or \"remote\"", a.SyncConflict)
+	}
+	return nil
+}
+
+// validateSample validates the --sample flag and converts the percentage into a ratio.
+func (a *Command) validateSample() error {
+	if a.Sample == "" {
+		return nil
+	}
+	pct, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(a.Sample, "%")), 64)
+	if err != nil || pct <= 0 || pct > 100 {
+		return errcat.User.Newf("invalid --sample %q, expected a percentage greater than 0 and at most 100", a.Sample)
+	}
+	if a.Mirror {
+		return errcat.User.New("--sample cannot be used with --mirror")
+	}
+	a.SampleRatio = pct / 100
+	return nil
+}
+
+// validateHTTPFlags validates the --http-xxx flags and, when any of them are used, switches the
+// mechanism to "http" and converts the flags into mechanism arguments for the traffic-agent.
+func (a *Command) validateHTTPFlags(cmd *cobra.Command) error {
+	var args []string
+	for _, h := range a.HTTPHeader {
+		if k, _, ok := strings.Cut(h, "="); !ok || k == "" {
+			return errcat.User.Newf("invalid --http-header %q, expected KEY=VALUE", h)
+		}
+		args = append(args, "--http-header="+h)
+	}
+	pathFlags := 0
+	for _, pf := range []struct {
+		name  string
+		value string
+	}{
+		{"http-path-equal", a.HTTPPathEqual},
+		{"http-path-prefix", a.HTTPPathPrefix},
+		{"http-path-regex", a.HTTPPathRegex},
+	} {
+		if pf.value != "" {
+			args = append(args, "--"+pf.name+"="+pf.value)
+			pathFlags++
+		}
+	}
+	if pathFlags > 1 {
+		return errcat.User.New("only one of --http-path-equal, --http-path-prefix, and --http-path-regex can be used")
+	}
+	if len(args) == 0 {
+		return nil
+	}
+	if cmd.Flag("mechanism").Changed && a.Mechanism != "http" {
+		return errcat.User.Newf("the --http-xxx flags cannot be used with --mechanism=%s", a.Mechanism)
+	}
+	a.Mechanism = "http"
+	a.MechanismArgs = args
+	return nil
+}
+
 func (a *Command) Run(cmd *cobra.Command, positional []string) error {
 	if err := a.Validate(cmd, positional); err != nil {
 		return err
@@ -222,6 +455,18 @@ func (a *Command) GetMountPoint(ctx context.Context) (string, bool, error) {
 		return "", true, nil
 	}
 
+	if doMount && a.MountMode == "sync" {
+		// The synchronized copy is a plain directory, also on Windows.
+		if mountPoint == "" {
+			mountPoint, err = os.MkdirTemp("", "telsync-")
+			return mountPoint, true, err
+		}
+		if mountPoint, err = filepath.Abs(mountPoint); err != nil {
+			return "", false, err
+		}
+		return mountPoint, true, os.MkdirAll(mountPoint, 0o700)
+	}
+
 	if doMount {
 		var cwd string
 		cwd, err = os.Getwd()
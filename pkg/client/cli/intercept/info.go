@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"math"
 	"net"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/telepresenceio/telepresence/rpc/v2/manager"
 	"github.com/telepresenceio/telepresence/v2/pkg/client"
@@ -46,6 +49,13 @@ type Info struct {
 	Global        bool              `json:"global,omitempty"          yaml:"global,omitempty"`
 	PreviewURL    string            `json:"preview_url,omitempty"     yaml:"preview_url,omitempty"`
 	Ingress       *Ingress          `json:"ingress,omitempty"         yaml:"ingress,omitempty"`
+	TTL           string            `json:"ttl,omitempty"             yaml:"ttl,omitempty"`
+	IdleTimeout   string            `json:"idle_timeout,omitempty"    yaml:"idle_timeout,omitempty"`
+	ExpiresAt     *time.Time        `json:"expires_at,omitempty"      yaml:"expires_at,omitempty"`
+	Fallback      string            `json:"fallback,omitempty"        yaml:"fallback,omitempty"`
+	HealthCheck   string            `json:"health_check,omitempty"    yaml:"health_check,omitempty"`
+	Pods          []string          `json:"pods,omitempty"            yaml:"pods,omitempty"`
+	Sample        string            `json:"sample,omitempty"          yaml:"sample,omitempty"`
 	debug         bool
 }
 
@@ -78,9 +88,12 @@ func NewMount(ctx context.Context, ii *manager.InterceptInfo, mountError string)
 	}
 	if ii.MountPoint != "" {
 		var port int32
-		if client.GetConfig(ctx).Intercept.UseFtp {
+		switch ic := client.GetConfig(ctx).Intercept; {
+		case ic.UseGrpcFs:
+			port = ii.GrpcFsPort
+		case ic.UseFtp:
 			port = ii.FtpPort
-		} else {
+		default:
 			port = ii.SftpPort
 		}
 		return &Mount{
@@ -96,7 +109,7 @@ func NewMount(ctx context.Context, ii *manager.InterceptInfo, mountError string)
 
 func NewInfo(ctx context.Context, ii *manager.InterceptInfo, mountError string) *Info {
 	spec := ii.Spec
-	return &Info{
+	info := &Info{
 		ID:            ii.Id,
 		Name:          spec.Name,
 		Disposition:   ii.Disposition.String(),
@@ -114,6 +127,30 @@ func NewInfo(ctx context.Context, ii *manager.InterceptInfo, mountError string)
 		PreviewURL:    PreviewURL(ii.PreviewDomain),
 		Ingress:       NewIngress(ii.PreviewSpec),
 	}
+	if spec.Ttl != nil {
+		info.TTL = spec.Ttl.AsDuration().String()
+	}
+	if spec.IdleTimeout != nil {
+		info.IdleTimeout = spec.IdleTimeout.AsDuration().String()
+	}
+	if ii.ExpiresAt != nil {
+		ea := ii.ExpiresAt.AsTime()
+		info.ExpiresAt = &ea
+	}
+	if spec.Fallback || spec.HealthCheckPath != "" {
+		info.Fallback = "standby"
+		if ii.FallbackActive {
+			info.Fallback = "active"
+		}
+		info.HealthCheck = spec.HealthCheckPath
+	}
+	if spec.Pod != "" || spec.Replicas > 0 {
+		info.Pods = ii.SelectedPods
+	}
+	if r := spec.SampleRatio; r > 0 && r < 1 {
+		info.Sample = strconv.FormatFloat(math.Round(r*10000)/100, 'f', -1, 64) + "%"
+	}
+	return info
 }
 
 func (ii *Info) WriteTo(w io.Writer) (int64, error) {
@@ -122,7 +159,13 @@ func (ii *Info) WriteTo(w io.Writer) (int64, error) {
 	kvf.Add("Intercept name", ii.Name)
 	kvf.Add("State", func() string {
 		msg := ""
-		if manager.InterceptDispositionType_value[ii.Disposition] > int32(manager.InterceptDispositionType_WAITING) {
+		switch manager.InterceptDispositionType(manager.InterceptDispositionType_value[ii.Disposition]) {
+		case manager.InterceptDispositionType_UNSPECIFIED,
+			manager.InterceptDispositionType_ACTIVE,
+			manager.InterceptDispositionType_WAITING,
+			manager.InterceptDispositionType_PAUSED,
+			manager.InterceptDispositionType_DRAINING:
+		default:
 			msg += "error: "
 		}
 		msg += ii.Disposition
@@ -185,5 +228,30 @@ func (ii *Info) WriteTo(w io.Writer) (int64, error) {
 	if in := ii.Ingress; in != nil {
 		kvf.Add("Layer 5 Hostname", in.L5Host)
 	}
+	if ii.ExpiresAt != nil {
+		remaining := time.Until(*ii.ExpiresAt).Truncate(time.Second)
+		if remaining < 0 {
+			remaining = 0
+		}
+		kvf.Add("Expires in", remaining.String())
+	}
+	if ii.IdleTimeout != "" {
+		kvf.Add("Idle timeout", ii.IdleTimeout)
+	}
+	switch ii.Fallback {
+	case "active":
+		kvf.Add("Fallback", "active, traffic is sent to the intercepted container")
+	case "standby":
+		kvf.Add("Fallback", "standby")
+	}
+	if ii.HealthCheck != "" {
+		kvf.Add("Health check path", ii.HealthCheck)
+	}
+	if len(ii.Pods) > 0 {
+		kvf.Add("Intercepted pods", strings.Join(ii.Pods, ", "))
+	}
+	if ii.Sample != "" {
+		kvf.Add("Sample", ii.Sample+" of the new connections")
+	}
 	return kvf.WriteTo(w)
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/spf13/cobra"
 	grpcCodes "google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	empty "google.golang.org/protobuf/types/known/emptypb"
 	core "k8s.io/api/core/v1"
 
@@ -303,6 +304,18 @@ func (s *state) CreateRequest(ctx context.Context) (*connector.CreateInterceptRe
 
 	spec.Mechanism = s.Mechanism
 	spec.MechanismArgs = s.MechanismArgs
+	spec.Mirror = s.Mirror
+	spec.Fallback = s.Fallback
+	spec.HealthCheckPath = s.HealthCheckPath
+	spec.Pod = s.Pod
+	spec.Replicas = int32(s.Replicas)
+	spec.SampleRatio = s.SampleRatio
+	if s.TTL > 0 {
+		spec.Ttl = durationpb.New(s.TTL)
+	}
+	if s.IdleTimeout > 0 {
+		spec.IdleTimeout = durationpb.New(s.IdleTimeout)
+	}
 	spec.Agent = s.AgentName
 	spec.TargetHost = "127.0.0.1"
 
@@ -316,7 +329,18 @@ func (s *state) CreateRequest(ctx context.Context) (*connector.CreateInterceptRe
 
 	doMount := false
 	ir.LocalMountPort = int32(s.LocalMountPort)
-	if err = s.checkMountCapability(ctx); err == nil {
+	ir.RecordDir = s.RecordDir
+	if s.MountMode == "sync" {
+		// Synchronization doesn't require a remote mount capability.
+		if ir.MountPoint, doMount, err = s.GetMountPoint(ctx); err != nil {
+			return nil, err
+		}
+		ir.Sync = &connector.SyncOptions{
+			Include:  s.SyncInclude,
+			Exclude:  s.SyncExclude,
+			Conflict: s.SyncConflict,
+		}
+	} else if err = s.checkMountCapability(ctx); err == nil {
 		if ir.MountPoint, doMount, err = s.GetMountPoint(ctx); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,268 @@
+// Package profile implements the telepresence.yaml project file. The file declares named profiles, each
+// consisting of connect settings and a list of intercepts. A profile is brought up using "telepresence up"
+// and torn down again using "telepresence down".
+package profile
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/intercept"
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+)
+
+// DefaultFile is the name of the project file that is used unless another file is given.
+const DefaultFile = "telepresence.yaml"
+
+// Version is the version of the project file format. Files must declare it using the version key.
+const Version = 1
+
+// File is the content of a project file.
+type File struct {
+	Version  int                 `yaml:"version"`
+	Profiles map[string]*Profile `yaml:"profiles"`
+}
+
+// Profile is a named set of connect settings and intercepts.
+type Profile struct {
+	Connect    Connect      `yaml:"connect,omitempty"`
+	Intercepts []*Intercept `yaml:"intercepts,omitempty"`
+}
+
+// Connect contains the settings that are used when connecting to the cluster. Each setting
+// corresponds to a flag of the "telepresence connect" command.
+type Connect struct {
+	Context          string   `yaml:"context,omitempty"`
+	Kubeconfig       string   `yaml:"kubeconfig,omitempty"`
+	ManagerNamespace string   `yaml:"managerNamespace,omitempty"`
+	MappedNamespaces []string `yaml:"mappedNamespaces,omitempty"`
+	AlsoProxy        []string `yaml:"alsoProxy,omitempty"`
+	NeverProxy       []string `yaml:"neverProxy,omitempty"`
+	EgressVia        string   `yaml:"egressVia,omitempty"`
+}
+
+// Intercept contains the settings of one intercept. Each setting corresponds to a flag of
+// the "telepresence intercept" command, except for the Name, which is the intercept base
+// name, and the Args, which are the arguments that follow "--" on the command line.
+type Intercept struct {
+	Name            string   `yaml:"name"`
+	Workload        string   `yaml:"workload,omitempty"`
+	Namespace       string   `yaml:"namespace,omitempty"`
+	Service         string   `yaml:"service,omitempty"`
+	Port            string   `yaml:"port,omitempty"`
+	EnvFile         string   `yaml:"envFile,omitempty"`
+	EnvJSON         string   `yaml:"envJSON,omitempty"`
+	Mount           string   `yaml:"mount,omitempty"`
+	MountMode       string   `yaml:"mountMode,omitempty"`
+	SyncInclude     []string `yaml:"syncInclude,omitempty"`
+	SyncExclude     []string `yaml:"syncExclude,omitempty"`
+	SyncConflict    string   `yaml:"syncConflict,omitempty"`
+	ToPod           []string `yaml:"toPod,omitempty"`
+	DockerRun       bool     `yaml:"dockerRun,omitempty"`
+	DockerMount     string   `yaml:"dockerMount,omitempty"`
+	Mechanism       string   `yaml:"mechanism,omitempty"`
+	HTTPHeader      []string `yaml:"httpHeader,omitempty"`
+	HTTPPathEqual   string   `yaml:"httpPathEqual,omitempty"`
+	HTTPPathPrefix  string   `yaml:"httpPathPrefix,omitempty"`
+	HTTPPathRegex   string   `yaml:"httpPathRegex,omitempty"`
+	Mirror          bool     `yaml:"mirror,omitempty"`
+	TTL             string   `yaml:"ttl,omitempty"`
+	IdleTimeout     string   `yaml:"idleTimeout,omitempty"`
+	Fallback        bool     `yaml:"fallback,omitempty"`
+	HealthCheckPath string   `yaml:"healthCheckPath,omitempty"`
+	Pod             string   `yaml:"pod,omitempty"`
+	Replicas        int      `yaml:"replicas,omitempty"`
+	Sample          string   `yaml:"sample,omitempty"`
+	Record          string   `yaml:"record,omitempty"`
+	Args            []string `yaml:"args,omitempty"`
+}
+
+// Load reads and validates the given project file.
+func Load(path string) (*File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, errcat.User.Newf("project file %s not found", path)
+		}
+		return nil, err
+	}
+	f, err := Parse(bytes.NewReader(data))
+	if err != nil {
+		return nil, errcat.User.Newf("%s: %w", path, err)
+	}
+	return f, nil
+}
+
+// Parse parses and validates the content of a project file.
+func Parse(r io.Reader) (*File, error) {
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	var f File
+	if err := dec.Decode(&f); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("no profiles found")
+		}
+		return nil, err
+	}
+	switch f.Version {
+	case Version:
+	case 0:
+		return nil, errcat.User.Newf("version is missing. It must be %d", Version)
+	default:
+		return nil, errcat.User.Newf("version %d is not supported. It must be %d", f.Version, Version)
+	}
+	if len(f.Profiles) == 0 {
+		return nil, errors.New("no profiles found")
+	}
+	for name, p := range f.Profiles {
+		if p == nil {
+			return nil, fmt.Errorf("profile %q is empty", name)
+		}
+		names := make(map[string]struct{}, len(p.Intercepts))
+		for i, ic := range p.Intercepts {
+			if ic == nil || ic.Name == "" {
+				return nil, fmt.Errorf("profile %q: intercept %d has no name", name, i+1)
+			}
+			if _, dup := names[ic.Name]; dup {
+				return nil, fmt.Errorf("profile %q: intercept %q is declared more than once", name, ic.Name)
+			}
+			names[ic.Name] = struct{}{}
+		}
+	}
+	return &f, nil
+}
+
+// Select returns the profile with the given name. The name may be empty when the file declares
+// exactly one profile.
+func (f *File) Select(name string) (string, *Profile, error) {
+	if name == "" {
+		if len(f.Profiles) == 1 {
+			for name, p := range f.Profiles {
+				return name, p, nil
+			}
+		}
+		return "", nil, errcat.User.Newf("a profile must be given. Available profiles are: %s", strings.Join(f.Names(), ", "))
+	}
+	if p, ok := f.Profiles[name]; ok {
+		return name, p, nil
+	}
+	return "", nil, errcat.User.Newf("profile %q not found. Available profiles are: %s", name, strings.Join(f.Names(), ", "))
+}
+
+// Names returns the sorted names of all profiles.
+func (f *File) Names() []string {
+	names := make([]string, 0, len(f.Profiles))
+	for name := range f.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Flags returns the connect flags that correspond to the settings, keyed by flag name.
+func (c *Connect) Flags() map[string]string {
+	flags := make(map[string]string)
+	add := func(name, value string) {
+		if value != "" {
+			flags[name] = value
+		}
+	}
+	add("context", c.Context)
+	add("kubeconfig", c.Kubeconfig)
+	add("manager-namespace", c.ManagerNamespace)
+	add("mapped-namespaces", strings.Join(c.MappedNamespaces, ","))
+	add("also-proxy", strings.Join(c.AlsoProxy, ","))
+	add("never-proxy", strings.Join(c.NeverProxy, ","))
+	add("egress-via", c.EgressVia)
+	return flags
+}
+
+// CommandLine returns the arguments that would be given to the "telepresence intercept" command
+// in order to create this intercept.
+func (ic *Intercept) CommandLine() []string {
+	args := []string{ic.Name}
+	add := func(name, value string) {
+		if value != "" {
+			args = append(args, "--"+name+"="+value)
+		}
+	}
+	add("workload", ic.Workload)
+	add("namespace", ic.Namespace)
+	add("service", ic.Service)
+	add("port", ic.Port)
+	add("env-file", ic.EnvFile)
+	add("env-json", ic.EnvJSON)
+	add("mount", ic.Mount)
+	add("mount-mode", ic.MountMode)
+	for _, g := range ic.SyncInclude {
+		add("sync-include", g)
+	}
+	for _, g := range ic.SyncExclude {
+		add("sync-exclude", g)
+	}
+	add("sync-conflict", ic.SyncConflict)
+	for _, tp := range ic.ToPod {
+		add("to-pod", tp)
+	}
+	if ic.DockerRun {
+		add("docker-run", strconv.FormatBool(ic.DockerRun))
+	}
+	add("docker-mount", ic.DockerMount)
+	add("mechanism", ic.Mechanism)
+	for _, h := range ic.HTTPHeader {
+		add("http-header", h)
+	}
+	add("http-path-equal", ic.HTTPPathEqual)
+	add("http-path-prefix", ic.HTTPPathPrefix)
+	add("http-path-regex", ic.HTTPPathRegex)
+	if ic.Mirror {
+		add("mirror", strconv.FormatBool(ic.Mirror))
+	}
+	add("record", ic.Record)
+	add("ttl", ic.TTL)
+	add("idle-timeout", ic.IdleTimeout)
+	if ic.Fallback {
+		add("fallback", strconv.FormatBool(ic.Fallback))
+	}
+	add("health-check-path", ic.HealthCheckPath)
+	add("pod", ic.Pod)
+	if ic.Replicas > 0 {
+		add("replicas", strconv.Itoa(ic.Replicas))
+	}
+	add("sample", ic.Sample)
+	if len(ic.Args) > 0 {
+		args = append(args, "--")
+		args = append(args, ic.Args...)
+	}
+	return args
+}
+
+// Command parses the CommandLine of this intercept using the flags of the "telepresence intercept" command
+// and validates the result using the same rules as that command. The returned cobra.Command uses the given
+// context and the standard streams of the given parent.
+func (ic *Intercept) Command(ctx context.Context, parent *cobra.Command) (*cobra.Command, *intercept.Command, error) {
+	a := &intercept.Command{}
+	cmd := &cobra.Command{Use: "intercept"}
+	a.AddFlags(cmd.Flags())
+	cmd.SetContext(ctx)
+	cmd.SetIn(parent.InOrStdin())
+	cmd.SetOut(parent.OutOrStdout())
+	cmd.SetErr(parent.ErrOrStderr())
+	if err := cmd.ParseFlags(ic.CommandLine()); err != nil {
+		return nil, nil, errcat.User.Newf("intercept %q: %w", ic.Name, err)
+	}
+	if err := a.Validate(cmd, cmd.Flags().Args()); err != nil {
+		return nil, nil, errcat.User.Newf("intercept %q: %w", ic.Name, err)
+	}
+	return cmd, a, nil
+}
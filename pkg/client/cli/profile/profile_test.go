@@ -0,0 +1,166 @@
+package profile_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/telepresenceio/telepresence/v2/pkg/client"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/profile"
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+)
+
+const projectFile = `
+version: 1
+profiles:
+  dev:
+    connect:
+      context: dev-cluster
+      managerNamespace: ambassador
+      mappedNamespaces:
+        - orders
+        - payments
+      egressVia: frontend.orders
+    intercepts:
+      - name: orders
+        namespace: orders
+        port: 8080:http
+        mount: "false"
+        httpHeader:
+          - x-user=alice
+        ttl: 2h
+        idleTimeout: 30m
+      - name: payments
+        workload: payments-v2
+        dockerRun: true
+        port: 9090:8080
+        args: [--rm, payments:dev]
+  staging:
+    intercepts:
+      - name: web
+        mountMode: sync
+        syncExclude:
+          - node_modules
+        healthCheckPath: /healthz
+        replicas: 2
+        sample: 10%
+`
+
+func TestParse(t *testing.T) {
+	f, err := profile.Parse(strings.NewReader(projectFile))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dev", "staging"}, f.Names())
+
+	_, p, err := f.Select("dev")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"context":           "dev-cluster",
+		"manager-namespace": "ambassador",
+		"mapped-namespaces": "orders,payments",
+		"egress-via":        "frontend.orders",
+	}, p.Connect.Flags())
+	require.Len(t, p.Intercepts, 2)
+	assert.Equal(t,
+		[]string{"orders", "--namespace=orders", "--port=8080:http", "--mount=false", "--http-header=x-user=alice", "--ttl=2h", "--idle-timeout=30m"},
+		p.Intercepts[0].CommandLine())
+	assert.Equal(t,
+		[]string{"payments", "--workload=payments-v2", "--port=9090:8080", "--docker-run=true", "--", "--rm", "payments:dev"},
+		p.Intercepts[1].CommandLine())
+
+	_, p, err = f.Select("staging")
+	require.NoError(t, err)
+	assert.Equal(t,
+		[]string{"web", "--mount-mode=sync", "--sync-exclude=node_modules", "--health-check-path=/healthz", "--replicas=2", "--sample=10%"},
+		p.Intercepts[0].CommandLine())
+
+	_, _, err = f.Select("")
+	assert.ErrorContains(t, err, "dev, staging")
+	_, _, err = f.Select("prod")
+	assert.ErrorContains(t, err, `profile "prod" not found`)
+}
+
+func TestParse_invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		err     string
+	}{
+		{"empty", "", "no profiles found"},
+		{"no profiles", "version: 1\nprofiles: {}", "no profiles found"},
+		{"unknown key", "version: 1\nprofiles:\n  dev:\n    intercepts:\n      - name: a\n        prot: 8080", "field prot not found"},
+		{"no name", "version: 1\nprofiles:\n  dev:\n    intercepts:\n      - port: \"8080\"", "intercept 1 has no name"},
+		{"duplicate", "version: 1\nprofiles:\n  dev:\n    intercepts:\n      - name: a\n      - name: a", `intercept "a" is declared more than once`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := profile.Parse(strings.NewReader(tt.content))
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestParse_version(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		err     string
+	}{
+		{"missing", "profiles:\n  dev: {}", "version is missing. It must be 1"},
+		{"unknown", "version: 2\nprofiles:\n  dev: {}", "version 2 is not supported. It must be 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := profile.Parse(strings.NewReader(tt.content))
+			require.ErrorContains(t, err, tt.err)
+			assert.Equal(t, errcat.User, errcat.GetCategory(err))
+		})
+	}
+	f, err := profile.Parse(strings.NewReader("version: 1\nprofiles:\n  dev: {}"))
+	require.NoError(t, err)
+	assert.Equal(t, profile.Version, f.Version)
+}
+
+func TestIntercept_Command(t *testing.T) {
+	ctx := dlog.NewTestContext(t, false)
+	cfg := client.GetDefaultConfig()
+	ctx = client.WithConfig(ctx, &cfg)
+	parent := &cobra.Command{}
+
+	f, err := profile.Parse(strings.NewReader(projectFile))
+	require.NoError(t, err)
+	_, p, err := f.Select("dev")
+	require.NoError(t, err)
+
+	_, ic, err := p.Intercepts[0].Command(ctx, parent)
+	require.NoError(t, err)
+	assert.Equal(t, "orders-orders", ic.Name)
+	assert.Equal(t, "orders", ic.AgentName)
+	assert.Equal(t, []string{"x-user=alice"}, ic.HTTPHeader)
+	assert.Equal(t, 2*time.Hour, ic.TTL)
+	assert.Equal(t, 30*time.Minute, ic.IdleTimeout)
+
+	_, ic, err = p.Intercepts[1].Command(ctx, parent)
+	require.NoError(t, err)
+	assert.Equal(t, "payments", ic.Name)
+	assert.True(t, ic.DockerRun)
+	assert.Equal(t, []string{"--rm", "payments:dev"}, ic.Cmdline)
+
+	_, p, err = f.Select("staging")
+	require.NoError(t, err)
+	_, ic, err = p.Intercepts[0].Command(ctx, parent)
+	require.NoError(t, err)
+	assert.Equal(t, "sync", ic.MountMode)
+	assert.Equal(t, []string{"node_modules"}, ic.SyncExclude)
+	assert.True(t, ic.Fallback)
+	assert.Equal(t, 2, ic.Replicas)
+	assert.Equal(t, 0.1, ic.SampleRatio)
+
+	bad := &profile.Intercept{Name: "bad", Port: "x:y:z:w"}
+	_, _, err = bad.Command(ctx, parent)
+	assert.ErrorContains(t, err, `intercept "bad"`)
+}
@@ -749,6 +749,7 @@ type Intercept struct {
 	AppProtocolStrategy k8sapi.AppProtocolStrategy `json:"appProtocolStrategy,omitempty" yaml:"appProtocolStrategy,omitempty"`
 	DefaultPort         int                        `json:"defaultPort,omitempty" yaml:"defaultPort,omitempty"`
 	UseFtp              bool                       `json:"useFtp,omitempty" yaml:"useFtp,omitempty"`
+	UseGrpcFs           bool                       `json:"useGrpcFs,omitempty" yaml:"useGrpcFs,omitempty"`
 }
 
 func (ic *Intercept) merge(o *Intercept) {
@@ -761,6 +762,14 @@ func (ic *Intercept) merge(o *Intercept) {
 	if o.UseFtp {
 		ic.UseFtp = true
 	}
+	if o.UseGrpcFs {
+		ic.UseGrpcFs = true
+	}
+}
+
+// UsesFuseFTP returns true if remote mounts are performed by the fuseftp FUSE driver.
+func (ic *Intercept) UsesFuseFTP() bool {
+	return ic.UseFtp || ic.UseGrpcFs
 }
 
 // IsZero controls whether this element will be included in marshalled output.
@@ -780,6 +789,9 @@ func (ic Intercept) MarshalYAML() (any, error) {
 	if ic.UseFtp {
 		im["useFtp"] = true
 	}
+	if ic.UseGrpcFs {
+		im["useGrpcFs"] = true
+	}
 	return im, nil
 }
 
@@ -0,0 +1,252 @@
+package remotefs
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"net"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	ftp "github.com/fclairamb/ftpserverlib"
+	"github.com/spf13/afero"
+
+	"github.com/datawire/dlib/dlog"
+	ftpserver "github.com/datawire/go-ftpserver"
+)
+
+// ftpDriver is the driver of an FTP server that serves a file system on the loopback interface.
+// It lets the fuseftp FUSE driver mount file systems that aren't served by an FTP server in
+// the cluster.
+type ftpDriver struct {
+	ftp.Settings
+	ctx context.Context
+	fs  afero.Fs
+
+	sync.Mutex
+	clients map[uint32]ftp.ClientContext
+}
+
+// startFTPServer starts an FTP server that serves the given file system on the loopback
+// interface, and returns its port and the directory that the file system is served in. The
+// name of the directory is a random secret, which must be passed to fuseftp as the directory
+// to mount. The server is stopped when the context is cancelled.
+func startFTPServer(ctx context.Context, fs afero.Fs) (uint16, string, error) {
+	secret := make([]byte, 16)
+	if _, err := rand.Read(secret); err != nil {
+		return 0, "", err
+	}
+	dir := "/" + hex.EncodeToString(secret)
+	lc := net.ListenConfig{}
+	l, err := lc.Listen(ctx, "tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, "", err
+	}
+	a := l.Addr().(*net.TCPAddr)
+	d := &ftpDriver{
+		ctx: ctx,
+		fs:  &secretFs{fs: fs, dir: dir},
+		Settings: ftp.Settings{
+			Banner:              "Telepresence remote mount",
+			PublicHost:          "127.0.0.1",
+			DefaultTransferType: ftp.TransferTypeBinary,
+			Listener:            l,
+			ListenAddr:          a.String(),
+			IdleTimeout:         300,
+		},
+		clients: make(map[uint32]ftp.ClientContext),
+	}
+	s := ftp.NewFtpServer(d)
+	s.Logger = ftpserver.Logger(ctx)
+	go func() {
+		<-ctx.Done()
+		d.Lock()
+		for _, c := range d.clients {
+			_ = c.Close()
+		}
+		d.Unlock()
+		if err := s.Stop(); err != nil {
+			dlog.Errorf(ctx, "failed to stop ftp server: %v", err)
+		}
+	}()
+	go func() {
+		if err := s.ListenAndServe(); err != nil && ctx.Err() == nil {
+			dlog.Errorf(ctx, "ftp server failed: %v", err)
+		}
+	}()
+	dlog.Debugf(ctx, "FTP server for remote mount listening on %s", a)
+	return uint16(a.Port), dir, nil
+}
+
+func (d *ftpDriver) ClientConnected(cc ftp.ClientContext) (string, error) {
+	d.Lock()
+	d.clients[cc.ID()] = cc
+	d.Unlock()
+	cc.SetDebug(dlog.MaxLogLevel(d.ctx) >= dlog.LogLevelTrace)
+	return "telepresence", nil
+}
+
+func (d *ftpDriver) ClientDisconnected(cc ftp.ClientContext) {
+	d.Lock()
+	delete(d.clients, cc.ID())
+	d.Unlock()
+}
+
+// AuthUser accepts any user, because fuseftp can't present credentials. Access is instead
+// authorized by the name of the secret directory that the file system is served in.
+func (d *ftpDriver) AuthUser(_ ftp.ClientContext, _, _ string) (ftp.ClientDriver, error) {
+	return d.fs, nil
+}
+
+func (d *ftpDriver) GetTLSConfig() (*tls.Config, error) {
+	return nil, errors.New("not enabled")
+}
+
+func (d *ftpDriver) GetSettings() (*ftp.Settings, error) {
+	return &d.Settings, nil
+}
+
+// secretFs serves a file system in a directory with a secret name, and denies access to
+// everything outside of that directory, including the root, so that a local user that
+// connects to the FTP server can't find the secret.
+type secretFs struct {
+	fs  afero.Fs
+	dir string
+}
+
+// path returns the name in the served file system of the given name, or an *fs.PathError
+// if the name isn't in the secret directory.
+func (s *secretFs) path(op, name string) (string, error) {
+	cn := path.Clean("/" + name)
+	switch {
+	case cn == s.dir:
+		return "/", nil
+	case strings.HasPrefix(cn, s.dir+"/"):
+		return cn[len(s.dir):], nil
+	default:
+		return "", &fs.PathError{Op: op, Path: name, Err: fs.ErrPermission}
+	}
+}
+
+func (s *secretFs) Name() string {
+	return s.fs.Name()
+}
+
+func (s *secretFs) Stat(name string) (fs.FileInfo, error) {
+	p, err := s.path("stat", name)
+	if err != nil {
+		return nil, err
+	}
+	return s.fs.Stat(p)
+}
+
+// ReadDir lets the FTP server list directories using the ReadDir of the served file system,
+// when it has one.
+func (s *secretFs) ReadDir(name string) ([]fs.FileInfo, error) {
+	p, err := s.path("readdir", name)
+	if err != nil {
+		return nil, err
+	}
+	if rd, ok := s.fs.(ftp.ClientDriverExtensionFileList); ok {
+		return rd.ReadDir(p)
+	}
+	return afero.ReadDir(s.fs, p)
+}
+
+func (s *secretFs) Create(name string) (afero.File, error) {
+	p, err := s.path("create", name)
+	if err != nil {
+		return nil, err
+	}
+	return s.fs.Create(p)
+}
+
+func (s *secretFs) Open(name string) (afero.File, error) {
+	p, err := s.path("open", name)
+	if err != nil {
+		return nil, err
+	}
+	return s.fs.Open(p)
+}
+
+func (s *secretFs) OpenFile(name string, flag int, perm os.FileMode) (afero.File, error) {
+	p, err := s.path("open", name)
+	if err != nil {
+		return nil, err
+	}
+	return s.fs.OpenFile(p, flag, perm)
+}
+
+func (s *secretFs) Mkdir(name string, perm os.FileMode) error {
+	p, err := s.path("mkdir", name)
+	if err != nil {
+		return err
+	}
+	return s.fs.Mkdir(p, perm)
+}
+
+func (s *secretFs) MkdirAll(name string, perm os.FileMode) error {
+	p, err := s.path("mkdir", name)
+	if err != nil {
+		return err
+	}
+	return s.fs.MkdirAll(p, perm)
+}
+
+func (s *secretFs) Remove(name string) error {
+	p, err := s.path("remove", name)
+	if err != nil {
+		return err
+	}
+	return s.fs.Remove(p)
+}
+
+func (s *secretFs) RemoveAll(name string) error {
+	p, err := s.path("remove", name)
+	if err != nil {
+		return err
+	}
+	return s.fs.RemoveAll(p)
+}
+
+func (s *secretFs) Rename(oldName, newName string) error {
+	op, err := s.path("rename", oldName)
+	if err != nil {
+		return err
+	}
+	np, err := s.path("rename", newName)
+	if err != nil {
+		return err
+	}
+	return s.fs.Rename(op, np)
+}
+
+func (s *secretFs) Chmod(name string, mode os.FileMode) error {
+	p, err := s.path("chmod", name)
+	if err != nil {
+		return err
+	}
+	return s.fs.Chmod(p, mode)
+}
+
+func (s *secretFs) Chown(name string, uid, gid int) error {
+	p, err := s.path("chown", name)
+	if err != nil {
+		return err
+	}
+	return s.fs.Chown(p, uid, gid)
+}
+
+func (s *secretFs) Chtimes(name string, atime time.Time, mtime time.Time) error {
+	p, err := s.path("chtimes", name)
+	if err != nil {
+		return err
+	}
+	return s.fs.Chtimes(p, atime, mtime)
+}
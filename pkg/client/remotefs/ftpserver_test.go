@@ -0,0 +1,46 @@
+package remotefs
+
+import (
+	"io/fs"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretFs(t *testing.T) {
+	mfs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(mfs, "/app/config.yaml", []byte("debug: true"), 0o644))
+	sfs := &secretFs{fs: mfs, dir: "/0123456789abcdef"}
+
+	data, err := afero.ReadFile(sfs, "/0123456789abcdef/app/config.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, "debug: true", string(data))
+
+	fis, err := sfs.ReadDir("/0123456789abcdef")
+	require.NoError(t, err)
+	require.Len(t, fis, 1)
+	assert.Equal(t, "app", fis[0].Name())
+
+	require.NoError(t, sfs.Rename("/0123456789abcdef/app/config.yaml", "/0123456789abcdef/app/config.yml"))
+	_, err = mfs.Stat("/app/config.yml")
+	assert.NoError(t, err)
+
+	for _, name := range []string{
+		"/",
+		"/app/config.yml",
+		"/0123456789abcde",
+		"/0123456789abcdefg/app",
+		"/0123456789abcdef/../app/config.yml",
+	} {
+		_, err = sfs.Stat(name)
+		assert.ErrorIs(t, err, fs.ErrPermission, name)
+		_, err = sfs.ReadDir(name)
+		assert.ErrorIs(t, err, fs.ErrPermission, name)
+		_, err = sfs.Open(name)
+		assert.ErrorIs(t, err, fs.ErrPermission, name)
+	}
+	assert.ErrorIs(t, sfs.Rename("/0123456789abcdef/app/config.yml", "/config.yml"), fs.ErrPermission)
+	assert.ErrorIs(t, sfs.RemoveAll("/"), fs.ErrPermission)
+}
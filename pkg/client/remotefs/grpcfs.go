@@ -0,0 +1,628 @@
+package remotefs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/spf13/afero"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/dlib/dtime"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/agent"
+)
+
+const (
+	// statCacheTTL is how long the result of a Stat or ReadDir is cached. Changes reported by the
+	// agent invalidate cached entries earlier, so this only matters when such reports are lost.
+	statCacheTTL = 10 * time.Second
+
+	// readAheadSize is the minimum number of bytes that a read requests from the agent.
+	readAheadSize = 512 * 1024
+
+	// smallFileSize is the maximum size of files whose contents are cached.
+	smallFileSize = 128 * 1024
+
+	// contentCacheSize is the maximum total size of the cached file contents.
+	contentCacheSize = 64 * 1024 * 1024
+
+	// writeBufferSize is the number of bytes that are buffered before they are written to the agent.
+	writeBufferSize = 1024 * 1024
+)
+
+// grpcFs is an afero.Fs that uses the FileSystem service of a traffic-agent. It caches the results
+// of Stat and ReadDir, and the contents of small files, until the agent reports that they changed.
+type grpcFs struct {
+	ctx  context.Context
+	root string
+
+	sync.Mutex
+	client       rpc.FileSystemClient
+	stats        map[string]*statEntry
+	dirs         map[string]*dirEntry
+	contents     map[string][]byte
+	contentsSize int
+}
+
+type statEntry struct {
+	fi      fs.FileInfo
+	err     error
+	expires time.Time
+}
+
+type dirEntry struct {
+	entries []fs.FileInfo
+	expires time.Time
+}
+
+// fileInfo is an fs.FileInfo that is backed by an rpc.FileInfo.
+type fileInfo struct {
+	*rpc.FileInfo
+}
+
+func (fi fileInfo) Name() string       { return fi.FileInfo.Name }
+func (fi fileInfo) Size() int64        { return fi.FileInfo.Size }
+func (fi fileInfo) Mode() fs.FileMode  { return fs.FileMode(fi.FileInfo.Mode) }
+func (fi fileInfo) ModTime() time.Time { return fi.FileInfo.ModTime.AsTime() }
+func (fi fileInfo) IsDir() bool        { return fi.Mode().IsDir() }
+func (fi fileInfo) Sys() any           { return nil }
+
+// newGrpcFs returns a file system for the given remote directory. The context controls the
+// lifetime of the calls to the agent.
+func newGrpcFs(ctx context.Context, client rpc.FileSystemClient, root string) *grpcFs {
+	if root == "" {
+		root = "/"
+	}
+	return &grpcFs{
+		ctx:      ctx,
+		root:     root,
+		client:   client,
+		stats:    make(map[string]*statEntry),
+		dirs:     make(map[string]*dirEntry),
+		contents: make(map[string][]byte),
+	}
+}
+
+// setClient replaces the client, e.g. when the intercepted pod changes, and drops everything
+// that was cached.
+func (g *grpcFs) setClient(client rpc.FileSystemClient) {
+	g.Lock()
+	g.client = client
+	g.clearLocked()
+	g.Unlock()
+}
+
+func (g *grpcFs) getClient() rpc.FileSystemClient {
+	g.Lock()
+	defer g.Unlock()
+	return g.client
+}
+
+func (g *grpcFs) clearLocked() {
+	g.stats = make(map[string]*statEntry)
+	g.dirs = make(map[string]*dirEntry)
+	g.contents = make(map[string][]byte)
+	g.contentsSize = 0
+}
+
+// invalidate drops everything that is cached for the given remote paths.
+func (g *grpcFs) invalidate(paths ...string) {
+	g.Lock()
+	for _, p := range paths {
+		delete(g.stats, p)
+		delete(g.dirs, p)
+		if c, ok := g.contents[p]; ok {
+			g.contentsSize -= len(c)
+			delete(g.contents, p)
+		}
+	}
+	g.Unlock()
+}
+
+// invalidateMutated drops everything that is cached for the given remote path and its directory.
+func (g *grpcFs) invalidateMutated(rp string) {
+	g.invalidate(rp, path.Dir(rp))
+}
+
+// watchChanges invalidates cached entries as the agent reports changes, until the context is
+// cancelled. Everything is dropped when the stream of changes breaks, because changes that
+// happen before it is reestablished are lost.
+func (g *grpcFs) watchChanges(ctx context.Context) {
+	for ctx.Err() == nil {
+		stream, err := g.getClient().WatchChanges(ctx, &emptypb.Empty{})
+		if err == nil {
+			for {
+				var cs *rpc.Changes
+				if cs, err = stream.Recv(); err != nil {
+					break
+				}
+				g.invalidate(cs.Paths...)
+			}
+		}
+		g.Lock()
+		g.clearLocked()
+		g.Unlock()
+		if ctx.Err() == nil {
+			dlog.Debugf(ctx, "watch of remote file system changes ended: %v", err)
+			dtime.SleepWithContext(ctx, time.Second)
+		}
+	}
+}
+
+// remotePath returns the remote path of the given name, which is relative to the root.
+func (g *grpcFs) remotePath(name string) string {
+	return path.Join(g.root, path.Clean("/"+name))
+}
+
+// fromStatus translates a gRPC status error into an *fs.PathError that wraps the corresponding
+// fs error, so that the FTP server reports it correctly.
+func fromStatus(op, name string, err error) error {
+	if err == nil {
+		return nil
+	}
+	switch status.Code(err) {
+	case codes.NotFound:
+		err = fs.ErrNotExist
+	case codes.AlreadyExists:
+		err = fs.ErrExist
+	case codes.PermissionDenied:
+		err = fs.ErrPermission
+	case codes.InvalidArgument:
+		err = fs.ErrInvalid
+	default:
+		if st, ok := status.FromError(err); ok {
+			err = errors.New(st.Message())
+		}
+	}
+	return &fs.PathError{Op: op, Path: name, Err: err}
+}
+
+func (g *grpcFs) Name() string {
+	return "grpcfs"
+}
+
+func (g *grpcFs) Stat(name string) (fs.FileInfo, error) {
+	return g.stat(g.remotePath(name), name)
+}
+
+func (g *grpcFs) stat(rp, name string) (fs.FileInfo, error) {
+	now := time.Now()
+	g.Lock()
+	se, ok := g.stats[rp]
+	g.Unlock()
+	if ok && now.Before(se.expires) {
+		return se.fi, se.err
+	}
+
+	var fi fs.FileInfo
+	rfi, err := g.getClient().Stat(g.ctx, &rpc.PathRequest{Path: rp})
+	if err == nil {
+		fi = fileInfo{rfi}
+	} else if err = fromStatus("stat", name, err); !errors.Is(err, fs.ErrNotExist) {
+		// Only successful results and nonexistent files are cached.
+		return nil, err
+	}
+	g.Lock()
+	g.stats[rp] = &statEntry{fi: fi, err: err, expires: now.Add(statCacheTTL)}
+	g.Unlock()
+	return fi, err
+}
+
+// ReadDir implements ftpserver.ClientDriverExtensionFileList.
+func (g *grpcFs) ReadDir(name string) ([]fs.FileInfo, error) {
+	return g.readDir(g.remotePath(name), name)
+}
+
+func (g *grpcFs) readDir(rp, name string) ([]fs.FileInfo, error) {
+	now := time.Now()
+	g.Lock()
+	de, ok := g.dirs[rp]
+	g.Unlock()
+	if ok && now.Before(de.expires) {
+		return de.entries, nil
+	}
+
+	des, err := g.getClient().ReadDir(g.ctx, &rpc.PathRequest{Path: rp})
+	if err != nil {
+		return nil, fromStatus("readdir", name, err)
+	}
+	entries := make([]fs.FileInfo, len(des.Entries))
+	expires := now.Add(statCacheTTL)
+	g.Lock()
+	for i, e := range des.Entries {
+		fi := fileInfo{e}
+		entries[i] = fi
+		// Populate the stat cache too, since a listing is usually followed by a stat of each entry.
+		g.stats[path.Join(rp, e.Name)] = &statEntry{fi: fi, expires: expires}
+	}
+	g.dirs[rp] = &dirEntry{entries: entries, expires: expires}
+	g.Unlock()
+	return entries, nil
+}
+
+func (g *grpcFs) Create(name string) (afero.File, error) {
+	return g.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o666)
+}
+
+func (g *grpcFs) Open(name string) (afero.File, error) {
+	return g.OpenFile(name, os.O_RDONLY, 0)
+}
+
+func (g *grpcFs) OpenFile(name string, flag int, perm fs.FileMode) (afero.File, error) {
+	rp := g.remotePath(name)
+	fi, err := g.stat(rp, name)
+	switch {
+	case errors.Is(err, fs.ErrNotExist) && flag&os.O_CREATE != 0:
+		var rfi *rpc.FileInfo
+		rfi, err = g.getClient().Create(g.ctx, &rpc.CreateRequest{Path: rp, Mode: uint32(perm), Exclusive: flag&os.O_EXCL != 0})
+		g.invalidateMutated(rp)
+		if err != nil {
+			return nil, fromStatus("open", name, err)
+		}
+		fi = fileInfo{rfi}
+	case err != nil:
+		return nil, &fs.PathError{Op: "open", Path: name, Err: errors.Unwrap(err)}
+	case flag&(os.O_CREATE|os.O_EXCL) == os.O_CREATE|os.O_EXCL:
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrExist}
+	case fi.IsDir():
+		if flag&(os.O_WRONLY|os.O_RDWR) != 0 {
+			return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+		}
+		return &grpcDir{fs: g, rp: rp, name: name, fi: fi}, nil
+	case flag&os.O_TRUNC != 0 && flag&(os.O_WRONLY|os.O_RDWR) != 0:
+		_, err = g.getClient().Truncate(g.ctx, &rpc.TruncateRequest{Path: rp})
+		g.invalidateMutated(rp)
+		if err != nil {
+			return nil, fromStatus("open", name, err)
+		}
+		fi, _ = g.stat(rp, name)
+	}
+	f := &grpcFile{fs: g, rp: rp, name: name, flag: flag}
+	if flag&os.O_APPEND != 0 && fi != nil {
+		f.offset = fi.Size()
+	}
+	return f, nil
+}
+
+func (g *grpcFs) Mkdir(name string, perm fs.FileMode) error {
+	rp := g.remotePath(name)
+	_, err := g.getClient().Mkdir(g.ctx, &rpc.CreateRequest{Path: rp, Mode: uint32(perm)})
+	g.invalidateMutated(rp)
+	return fromStatus("mkdir", name, err)
+}
+
+func (g *grpcFs) MkdirAll(name string, perm fs.FileMode) error {
+	if fi, err := g.Stat(name); err == nil {
+		if fi.IsDir() {
+			return nil
+		}
+		return &fs.PathError{Op: "mkdir", Path: name, Err: fs.ErrExist}
+	}
+	if parent := path.Dir(path.Clean("/" + name)); parent != "/" {
+		if err := g.MkdirAll(parent, perm); err != nil {
+			return err
+		}
+	}
+	err := g.Mkdir(name, perm)
+	if errors.Is(err, fs.ErrExist) {
+		err = nil
+	}
+	return err
+}
+
+func (g *grpcFs) Remove(name string) error {
+	rp := g.remotePath(name)
+	_, err := g.getClient().Remove(g.ctx, &rpc.RemoveRequest{Path: rp})
+	g.invalidateMutated(rp)
+	return fromStatus("remove", name, err)
+}
+
+func (g *grpcFs) RemoveAll(name string) error {
+	rp := g.remotePath(name)
+	_, err := g.getClient().Remove(g.ctx, &rpc.RemoveRequest{Path: rp, Recursive: true})
+	g.Lock()
+	g.clearLocked()
+	g.Unlock()
+	return fromStatus("remove", name, err)
+}
+
+func (g *grpcFs) Rename(oldName, newName string) error {
+	_, err := g.getClient().Rename(g.ctx, &rpc.RenameRequest{OldPath: g.remotePath(oldName), NewPath: g.remotePath(newName)})
+	// A renamed directory changes the paths of everything that it contains.
+	g.Lock()
+	g.clearLocked()
+	g.Unlock()
+	return fromStatus("rename", oldName, err)
+}
+
+func (g *grpcFs) Chmod(name string, mode fs.FileMode) error {
+	rp := g.remotePath(name)
+	_, err := g.getClient().Chmod(g.ctx, &rpc.ChmodRequest{Path: rp, Mode: uint32(mode)})
+	g.invalidateMutated(rp)
+	return fromStatus("chmod", name, err)
+}
+
+func (g *grpcFs) Chown(name string, _, _ int) error {
+	return &fs.PathError{Op: "chown", Path: name, Err: errors.New("not supported by the remote file system")}
+}
+
+func (g *grpcFs) Chtimes(name string, atime time.Time, mtime time.Time) error {
+	rp := g.remotePath(name)
+	_, err := g.getClient().Chtimes(g.ctx, &rpc.ChtimesRequest{
+		Path:       rp,
+		AccessTime: timestamppb.New(atime),
+		ModTime:    timestamppb.New(mtime),
+	})
+	g.invalidateMutated(rp)
+	return fromStatus("chtimes", name, err)
+}
+
+// read reads the contents of the remote file at the given offset into a new buffer. At least
+// readAheadSize bytes are requested so that sequential reads need fewer round trips.
+func (g *grpcFs) read(rp string, offset int64, size int) ([]byte, error) {
+	g.Lock()
+	c, ok := g.contents[rp]
+	g.Unlock()
+	if ok {
+		if offset >= int64(len(c)) {
+			return nil, io.EOF
+		}
+		return c[offset:], nil
+	}
+
+	length := int64(size)
+	if length < readAheadSize {
+		length = readAheadSize
+	}
+	stream, err := g.getClient().Read(g.ctx, &rpc.ReadRequest{Path: rp, Offset: offset, Length: length})
+	if err != nil {
+		return nil, err
+	}
+	var data []byte
+	for {
+		chunk, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		data = append(data, chunk.Data...)
+	}
+	if offset == 0 && int64(len(data)) < length && len(data) <= smallFileSize {
+		// The whole file was read and it's small enough to be cached.
+		g.Lock()
+		if g.contentsSize+len(data) > contentCacheSize {
+			g.contents = make(map[string][]byte)
+			g.contentsSize = 0
+		}
+		g.contents[rp] = data
+		g.contentsSize += len(data)
+		g.Unlock()
+	}
+	if len(data) == 0 {
+		return nil, io.EOF
+	}
+	return data, nil
+}
+
+// grpcFile is an afero.File for a regular file. Reads are served from a read-ahead buffer, and
+// writes are buffered until the buffer is full, or the file is synced, seeked, or closed.
+type grpcFile struct {
+	fs     *grpcFs
+	rp     string
+	name   string
+	flag   int
+	offset int64
+
+	readBuf    []byte
+	readOffset int64
+
+	writeBuf    []byte
+	writeOffset int64
+}
+
+func (f *grpcFile) Name() string {
+	return f.name
+}
+
+func (f *grpcFile) Stat() (fs.FileInfo, error) {
+	if err := f.Sync(); err != nil {
+		return nil, err
+	}
+	return f.fs.stat(f.rp, f.name)
+}
+
+func (f *grpcFile) Readdir(int) ([]fs.FileInfo, error) {
+	return nil, &fs.PathError{Op: "readdir", Path: f.name, Err: errors.New("not a directory")}
+}
+
+func (f *grpcFile) Readdirnames(int) ([]string, error) {
+	return nil, &fs.PathError{Op: "readdir", Path: f.name, Err: errors.New("not a directory")}
+}
+
+func (f *grpcFile) Read(p []byte) (int, error) {
+	n, err := f.ReadAt(p, f.offset)
+	f.offset += int64(n)
+	return n, err
+}
+
+func (f *grpcFile) ReadAt(p []byte, offset int64) (int, error) {
+	if f.flag&os.O_WRONLY != 0 {
+		return 0, &fs.PathError{Op: "read", Path: f.name, Err: fs.ErrPermission}
+	}
+	if err := f.Sync(); err != nil {
+		return 0, err
+	}
+	n := 0
+	for n < len(p) {
+		o := offset + int64(n)
+		if o < f.readOffset || o >= f.readOffset+int64(len(f.readBuf)) {
+			data, err := f.fs.read(f.rp, o, len(p)-n)
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return n, io.EOF
+				}
+				return n, fromStatus("read", f.name, err)
+			}
+			f.readBuf = data
+			f.readOffset = o
+		}
+		n += copy(p[n:], f.readBuf[o-f.readOffset:])
+	}
+	return n, nil
+}
+
+func (f *grpcFile) Seek(offset int64, whence int) (int64, error) {
+	if err := f.Sync(); err != nil {
+		return 0, err
+	}
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += f.offset
+	case io.SeekEnd:
+		fi, err := f.fs.stat(f.rp, f.name)
+		if err != nil {
+			return 0, err
+		}
+		offset += fi.Size()
+	}
+	if offset < 0 {
+		return 0, &fs.PathError{Op: "seek", Path: f.name, Err: fs.ErrInvalid}
+	}
+	f.offset = offset
+	return offset, nil
+}
+
+func (f *grpcFile) Write(p []byte) (int, error) {
+	n, err := f.WriteAt(p, f.offset)
+	f.offset += int64(n)
+	return n, err
+}
+
+func (f *grpcFile) WriteString(s string) (int, error) {
+	return f.Write([]byte(s))
+}
+
+func (f *grpcFile) WriteAt(p []byte, offset int64) (int, error) {
+	if f.flag&(os.O_WRONLY|os.O_RDWR) == 0 {
+		return 0, &fs.PathError{Op: "write", Path: f.name, Err: fs.ErrPermission}
+	}
+	f.readBuf = nil
+	if len(f.writeBuf) > 0 && offset != f.writeOffset+int64(len(f.writeBuf)) {
+		// Not contiguous with what's buffered.
+		if err := f.Sync(); err != nil {
+			return 0, err
+		}
+	}
+	if len(f.writeBuf) == 0 {
+		f.writeOffset = offset
+	}
+	f.writeBuf = append(f.writeBuf, p...)
+	if len(f.writeBuf) >= writeBufferSize {
+		if err := f.Sync(); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Sync writes the buffered data to the agent.
+func (f *grpcFile) Sync() error {
+	if len(f.writeBuf) == 0 {
+		return nil
+	}
+	_, err := f.fs.getClient().Write(f.fs.ctx, &rpc.WriteRequest{Path: f.rp, Offset: f.writeOffset, Data: f.writeBuf})
+	f.writeBuf = f.writeBuf[:0]
+	f.fs.invalidateMutated(f.rp)
+	return fromStatus("write", f.name, err)
+}
+
+func (f *grpcFile) Truncate(size int64) error {
+	if err := f.Sync(); err != nil {
+		return err
+	}
+	f.readBuf = nil
+	_, err := f.fs.getClient().Truncate(f.fs.ctx, &rpc.TruncateRequest{Path: f.rp, Size: size})
+	f.fs.invalidateMutated(f.rp)
+	return fromStatus("truncate", f.name, err)
+}
+
+func (f *grpcFile) Close() error {
+	return f.Sync()
+}
+
+// grpcDir is an afero.File for a directory.
+type grpcDir struct {
+	fs     *grpcFs
+	rp     string
+	name   string
+	fi     fs.FileInfo
+	offset int
+}
+
+func (d *grpcDir) Name() string {
+	return d.name
+}
+
+func (d *grpcDir) Stat() (fs.FileInfo, error) {
+	return d.fi, nil
+}
+
+func (d *grpcDir) Readdir(count int) ([]fs.FileInfo, error) {
+	entries, err := d.fs.readDir(d.rp, d.name)
+	if err != nil {
+		return nil, err
+	}
+	entries = append([]fs.FileInfo(nil), entries...)
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+	if d.offset >= len(entries) {
+		if count > 0 {
+			return nil, io.EOF
+		}
+		return nil, nil
+	}
+	entries = entries[d.offset:]
+	if count > 0 && count < len(entries) {
+		entries = entries[:count]
+	}
+	d.offset += len(entries)
+	return entries, nil
+}
+
+func (d *grpcDir) Readdirnames(count int) ([]string, error) {
+	entries, err := d.Readdir(count)
+	names := make([]string, len(entries))
+	for i, e := range entries {
+		names[i] = e.Name()
+	}
+	return names, err
+}
+
+func (d *grpcDir) Close() error {
+	return nil
+}
+
+func (d *grpcDir) isDirErr(op string) error {
+	return &fs.PathError{Op: op, Path: d.name, Err: errors.New("is a directory")}
+}
+
+func (d *grpcDir) Read([]byte) (int, error)           { return 0, d.isDirErr("read") }
+func (d *grpcDir) ReadAt([]byte, int64) (int, error)  { return 0, d.isDirErr("read") }
+func (d *grpcDir) Seek(int64, int) (int64, error)     { return 0, d.isDirErr("seek") }
+func (d *grpcDir) Write([]byte) (int, error)          { return 0, d.isDirErr("write") }
+func (d *grpcDir) WriteAt([]byte, int64) (int, error) { return 0, d.isDirErr("write") }
+func (d *grpcDir) WriteString(string) (int, error)    { return 0, d.isDirErr("write") }
+func (d *grpcDir) Sync() error                        { return nil }
+func (d *grpcDir) Truncate(int64) error               { return d.isDirErr("truncate") }
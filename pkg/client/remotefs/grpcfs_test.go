@@ -0,0 +1,184 @@
+package remotefs
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/agent"
+)
+
+// fakeFsClient serves a fixed set of files and counts the calls that it gets.
+type fakeFsClient struct {
+	rpc.FileSystemClient
+	files   map[string]string
+	stats   int32
+	readDir int32
+	reads   int32
+}
+
+func (c *fakeFsClient) info(p string) *rpc.FileInfo {
+	if p == "/exports" {
+		return &rpc.FileInfo{Name: "exports", Mode: uint32(fs.ModeDir | 0o755), ModTime: timestamppb.Now()}
+	}
+	return &rpc.FileInfo{Name: path.Base(p), Size: int64(len(c.files[p])), Mode: 0o644, ModTime: timestamppb.Now()}
+}
+
+func (c *fakeFsClient) Stat(_ context.Context, in *rpc.PathRequest, _ ...grpc.CallOption) (*rpc.FileInfo, error) {
+	atomic.AddInt32(&c.stats, 1)
+	if _, ok := c.files[in.Path]; !ok && in.Path != "/exports" {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return c.info(in.Path), nil
+}
+
+func (c *fakeFsClient) ReadDir(_ context.Context, in *rpc.PathRequest, _ ...grpc.CallOption) (*rpc.DirEntries, error) {
+	atomic.AddInt32(&c.readDir, 1)
+	des := &rpc.DirEntries{}
+	for p := range c.files {
+		if path.Dir(p) == in.Path {
+			des.Entries = append(des.Entries, c.info(p))
+		}
+	}
+	return des, nil
+}
+
+func (c *fakeFsClient) Read(_ context.Context, in *rpc.ReadRequest, _ ...grpc.CallOption) (rpc.FileSystem_ReadClient, error) {
+	atomic.AddInt32(&c.reads, 1)
+	data := c.files[in.Path]
+	if in.Offset < int64(len(data)) {
+		data = data[in.Offset:]
+	} else {
+		data = ""
+	}
+	if in.Length > 0 && int64(len(data)) > in.Length {
+		data = data[:in.Length]
+	}
+	return &fakeReadClient{data: []byte(data)}, nil
+}
+
+type fakeReadClient struct {
+	grpc.ClientStream
+	data []byte
+}
+
+func (r *fakeReadClient) Recv() (*rpc.Chunk, error) {
+	if len(r.data) == 0 {
+		return nil, io.EOF
+	}
+	n := len(r.data)
+	if n > 4 {
+		n = 4
+	}
+	c := &rpc.Chunk{Data: r.data[:n]}
+	r.data = r.data[n:]
+	return c, nil
+}
+
+func (r *fakeReadClient) Header() (metadata.MD, error) { return nil, nil }
+
+func TestGrpcFs_caching(t *testing.T) {
+	c := &fakeFsClient{files: map[string]string{
+		"/exports/package.json": `{"name": "app"}`,
+		"/exports/index.js":     "console.log('hello')",
+	}}
+	g := newGrpcFs(context.Background(), c, "/exports")
+
+	fis, err := g.ReadDir("/")
+	require.NoError(t, err)
+	assert.Len(t, fis, 2)
+
+	// The listing populates the stat cache.
+	fi, err := g.Stat("/index.js")
+	require.NoError(t, err)
+	assert.Equal(t, int64(20), fi.Size())
+	assert.Equal(t, int32(0), atomic.LoadInt32(&c.stats))
+
+	// Nonexistent files are cached too.
+	_, err = g.Stat("/missing.js")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+	_, err = g.Open("/missing.js")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&c.stats))
+
+	// Small files are read once, regardless of how small the reads are.
+	for i := 0; i < 2; i++ {
+		f, err := g.Open("/index.js")
+		require.NoError(t, err)
+		buf := make([]byte, 3)
+		var data []byte
+		for {
+			n, err := f.Read(buf)
+			data = append(data, buf[:n]...)
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+		}
+		require.NoError(t, f.Close())
+		assert.Equal(t, "console.log('hello')", string(data))
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&c.reads))
+
+	// A change reported by the agent invalidates the file and its directory.
+	g.invalidate("/exports/index.js", "/exports")
+	_, err = g.Stat("/index.js")
+	require.NoError(t, err)
+	_, err = g.ReadDir("/")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&c.stats))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&c.readDir))
+
+	f, err := g.Open("/index.js")
+	require.NoError(t, err)
+	buf := make([]byte, 7)
+	_, err = f.(io.ReaderAt).ReadAt(buf, 11)
+	require.NoError(t, err)
+	assert.Equal(t, "('hello", string(buf))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&c.reads))
+
+	// Entries expire.
+	g.Lock()
+	for _, se := range g.stats {
+		se.expires = time.Now()
+	}
+	g.Unlock()
+	_, err = g.Stat("/index.js")
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&c.stats))
+}
+
+func TestGrpcFs_directory(t *testing.T) {
+	c := &fakeFsClient{files: map[string]string{
+		"/exports/b": "",
+		"/exports/a": "",
+		"/exports/c": "",
+	}}
+	g := newGrpcFs(context.Background(), c, "/exports")
+	d, err := g.OpenFile("/", os.O_RDONLY, 0)
+	require.NoError(t, err)
+	names, err := d.Readdirnames(2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, names)
+	names, err = d.Readdirnames(2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c"}, names)
+	_, err = d.Readdirnames(2)
+	assert.Equal(t, io.EOF, err)
+
+	_, err = g.OpenFile("/", os.O_WRONLY, 0)
+	assert.ErrorIs(t, err, fs.ErrInvalid)
+}
@@ -0,0 +1,113 @@
+package remotefs
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/datawire/dlib/dcontext"
+	"github.com/datawire/dlib/dlog"
+	ftprpc "github.com/datawire/go-fuseftp/rpc"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/agent"
+	"github.com/telepresenceio/telepresence/v2/pkg/agentconfig"
+	"github.com/telepresenceio/telepresence/v2/pkg/iputil"
+)
+
+// grpcMounter mounts the remote file system using the FileSystem gRPC service of the traffic-agent.
+// The FUSE driver of fuseftp performs the mount. It runs in its own process, because it needs cgo,
+// and the only file system that it can mount is one that an FTP server serves. An FTP server on
+// the loopback interface therefore serves a caching file system backed by the gRPC service. The
+// FTP server serves it in a directory with a secret name that only fuseftp is told.
+type grpcMounter struct {
+	client ftprpc.FuseFTPClient
+	id     *ftprpc.MountIdentifier
+	fs     *grpcFs
+
+	// connLock guards conn, which is replaced when the intercept switches to another pod, and
+	// closed when the intercept ends.
+	connLock sync.Mutex
+	conn     *grpc.ClientConn
+}
+
+func NewGRPCMounter(client ftprpc.FuseFTPClient) Mounter {
+	return &grpcMounter{client: client}
+}
+
+// setConn makes the given connection the current one, and closes the previous one.
+func (m *grpcMounter) setConn(conn *grpc.ClientConn) {
+	m.connLock.Lock()
+	old := m.conn
+	m.conn = conn
+	m.connLock.Unlock()
+	if old != nil {
+		_ = old.Close()
+	}
+}
+
+func (m *grpcMounter) Start(ctx context.Context, id, clientMountPoint, mountPoint string, podIP net.IP, port uint16) error {
+	// Like the FTP mounter, the mount is controlled by the intercept context and survives pod changes.
+	addr := iputil.JoinIpPort(podIP, port)
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	fsClient := rpc.NewFileSystemClient(conn)
+
+	if m.fs != nil {
+		// Switch to the new pod. This leaves the FUSE driver intact.
+		dlog.Infof(ctx, "Switching remote address to %s for gRPC file system for intercept %q at %q", addr, id, clientMountPoint)
+		m.fs.setClient(fsClient)
+		m.setConn(conn)
+		return nil
+	}
+
+	dlog.Infof(ctx, "Mounting gRPC file system for intercept %q (address %s) at %q", id, addr, clientMountPoint)
+	// The exports are the root of the agent's file system.
+	gfs := newGrpcFs(ctx, fsClient, strings.TrimPrefix(mountPoint, agentconfig.ExportsMountPoint))
+
+	// The FTP server is stopped if the mount fails.
+	ftpCtx, ftpCancel := context.WithCancel(ctx)
+	ftpPort, dir, err := startFTPServer(ftpCtx, gfs)
+	if err != nil {
+		ftpCancel()
+		_ = conn.Close()
+		return err
+	}
+	mid, err := m.client.Mount(ctx, &ftprpc.MountRequest{
+		MountPoint: clientMountPoint,
+		FtpServer: &ftprpc.AddressAndPort{
+			Ip:   net.IPv4(127, 0, 0, 1).To4(),
+			Port: int32(ftpPort),
+		},
+		ReadTimeout: durationpb.New(5 * time.Second),
+		Directory:   dir,
+	})
+	if err != nil {
+		ftpCancel()
+		_ = conn.Close()
+		return err
+	}
+	m.id = mid
+	m.fs = gfs
+	m.setConn(conn)
+	go gfs.watchChanges(ctx)
+
+	// Ensure unmount when intercept context is cancelled
+	go func() {
+		<-ctx.Done()
+		ctx, cancel := context.WithTimeout(dcontext.WithoutCancel(ctx), time.Second)
+		defer cancel()
+		if _, err := m.client.Unmount(ctx, m.id); err != nil {
+			dlog.Error(ctx, err)
+		}
+		ftpCancel()
+		m.setConn(nil)
+	}()
+	return nil
+}
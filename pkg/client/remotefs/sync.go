@@ -0,0 +1,701 @@
+package remotefs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/dlib/dtime"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/agent"
+	"github.com/telepresenceio/telepresence/rpc/v2/connector"
+)
+
+const (
+	// ConflictNewer lets the side with the most recent modification time win a conflict.
+	ConflictNewer = "newer"
+
+	// ConflictLocal lets the local side win a conflict.
+	ConflictLocal = "local"
+
+	// ConflictRemote lets the remote side win a conflict.
+	ConflictRemote = "remote"
+
+	// syncTempPrefix is the prefix of the temporary files that are used when a file is
+	// copied. Such files are never synchronized.
+	syncTempPrefix = ".tel-sync-"
+
+	// syncDebounce is the time that the syncer waits for more changes to arrive before it
+	// processes a batch of changes.
+	syncDebounce = 100 * time.Millisecond
+
+	syncChunkSize = 1 << 20
+)
+
+// ValidConflictRule returns true if the given string is a valid conflict rule.
+func ValidConflictRule(rule string) bool {
+	switch rule {
+	case "", ConflictNewer, ConflictLocal, ConflictRemote:
+		return true
+	}
+	return false
+}
+
+// fileStat is the part of a file's status that the syncer uses to detect changes.
+type fileStat struct {
+	exists  bool
+	dir     bool
+	size    int64
+	mode    fs.FileMode
+	modTime time.Time
+}
+
+func localFileStat(fi fs.FileInfo) fileStat {
+	return fileStat{exists: true, dir: fi.IsDir(), size: fi.Size(), mode: fi.Mode(), modTime: fi.ModTime()}
+}
+
+func remoteFileStat(fi *rpc.FileInfo) fileStat {
+	mode := fs.FileMode(fi.Mode)
+	return fileStat{exists: true, dir: mode.IsDir(), size: fi.Size, mode: mode, modTime: fi.ModTime.AsTime()}
+}
+
+// equal returns true if the two stats describe the same state of the same file. The size
+// and modification time of directories change with their content, so they are ignored.
+func (s fileStat) equal(o fileStat) bool {
+	if s.exists != o.exists || s.dir != o.dir {
+		return false
+	}
+	return s.dir || !s.exists || s.size == o.size && s.modTime.Equal(o.modTime)
+}
+
+// sameContent returns true if the two stats, taken on different sides, are likely to
+// describe identical files. The resolution of the modification time may differ between
+// the file systems.
+func (s fileStat) sameContent(o fileStat) bool {
+	if s.exists != o.exists || s.dir != o.dir {
+		return false
+	}
+	return s.dir || !s.exists || s.size == o.size && s.modTime.Truncate(time.Second).Equal(o.modTime.Truncate(time.Second))
+}
+
+// syncState is the state of both sides of a file when it was last synchronized.
+type syncState struct {
+	local  fileStat
+	remote fileStat
+}
+
+// syncer keeps the mounts of an intercepted container and a local directory synchronized in
+// both directions. It uses the FileSystem gRPC service of the traffic-agent to access the
+// remote side and fsnotify to watch the local side.
+//
+// The syncer remembers the state of both sides of each file when it was last synchronized,
+// so that it can tell which side that changed. A file that has changed on both sides is a
+// conflict, which is resolved using the conflict rule.
+type syncer struct {
+	sync.Mutex
+	client  rpc.FileSystemClient
+	pending map[string]struct{}
+
+	remoteRoot string
+	localRoot  string
+	mounts     []string
+	include    []string
+	exclude    []string
+	conflict   string
+
+	// base is the synchronized state of each path, keyed by directory and name. It is only
+	// used by the goroutine that runs the synchronization.
+	base    map[string]map[string]*syncState
+	watcher *fsnotify.Watcher
+	changed chan struct{}
+	resync  chan struct{}
+}
+
+func newSyncer(client rpc.FileSystemClient, remoteRoot, localRoot string, mounts []string, opts *connector.SyncOptions) *syncer {
+	s := &syncer{
+		client:     client,
+		pending:    make(map[string]struct{}),
+		remoteRoot: path.Clean("/" + remoteRoot),
+		localRoot:  localRoot,
+		base:       make(map[string]map[string]*syncState),
+		changed:    make(chan struct{}, 1),
+		resync:     make(chan struct{}, 1),
+		conflict:   ConflictNewer,
+	}
+	for _, m := range mounts {
+		if m != "" {
+			s.mounts = append(s.mounts, path.Clean("/"+m))
+		}
+	}
+	if opts != nil {
+		s.include = opts.Include
+		s.exclude = opts.Exclude
+		if opts.Conflict != "" {
+			s.conflict = opts.Conflict
+		}
+	}
+	return s
+}
+
+func (s *syncer) setClient(client rpc.FileSystemClient) {
+	s.Lock()
+	s.client = client
+	s.Unlock()
+}
+
+func (s *syncer) getClient() rpc.FileSystemClient {
+	s.Lock()
+	defer s.Unlock()
+	return s.client
+}
+
+// run performs the synchronization until the context is cancelled.
+func (s *syncer) run(ctx context.Context) error {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+	s.watcher = w
+	go s.watchLocal(ctx)
+	go s.watchRemote(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.resync:
+			s.syncAll(ctx)
+		case <-s.changed:
+			dtime.SleepWithContext(ctx, syncDebounce)
+			for _, rel := range s.takePending() {
+				s.reconcile(ctx, rel, 1)
+			}
+		}
+	}
+}
+
+// watchRemote subscribes to the changes of the remote file system. A full synchronization
+// is requested each time the subscription starts, because changes may have been missed
+// while it was down.
+func (s *syncer) watchRemote(ctx context.Context) {
+	for ctx.Err() == nil {
+		stream, err := s.getClient().WatchChanges(ctx, &emptypb.Empty{})
+		if err == nil {
+			select {
+			case s.resync <- struct{}{}:
+			default:
+			}
+			for {
+				var cs *rpc.Changes
+				if cs, err = stream.Recv(); err != nil {
+					break
+				}
+				for _, p := range cs.Paths {
+					if rel, ok := s.relRemote(p); ok {
+						s.addPending(rel)
+					}
+				}
+			}
+		}
+		if ctx.Err() == nil {
+			dlog.Debugf(ctx, "watch of remote file system changes ended: %v", err)
+			dtime.SleepWithContext(ctx, time.Second)
+		}
+	}
+}
+
+func (s *syncer) watchLocal(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case err, ok := <-s.watcher.Errors:
+			if !ok {
+				return
+			}
+			dlog.Errorf(ctx, "watch of synchronized directory failed: %v", err)
+		case ev, ok := <-s.watcher.Events:
+			if !ok {
+				return
+			}
+			if rel, ok := s.relLocal(ev.Name); ok {
+				s.addPending(rel)
+			}
+		}
+	}
+}
+
+func (s *syncer) addPending(rel string) {
+	s.Lock()
+	s.pending[rel] = struct{}{}
+	s.Unlock()
+	select {
+	case s.changed <- struct{}{}:
+	default:
+	}
+}
+
+// takePending returns the pending paths sorted, so that directories are processed before
+// their content.
+func (s *syncer) takePending() []string {
+	s.Lock()
+	rels := make([]string, 0, len(s.pending))
+	for rel := range s.pending {
+		rels = append(rels, rel)
+	}
+	s.pending = make(map[string]struct{})
+	s.Unlock()
+	sort.Strings(rels)
+	return rels
+}
+
+// relRemote returns the path relative to the remote root, provided that it is subject to synchronization.
+func (s *syncer) relRemote(p string) (string, bool) {
+	p = path.Clean(p)
+	if s.remoteRoot != "/" {
+		if !strings.HasPrefix(p, s.remoteRoot+"/") {
+			return "", false
+		}
+		p = strings.TrimPrefix(p, s.remoteRoot)
+	}
+	return p, s.synced(p)
+}
+
+// relLocal returns the path relative to the local root, provided that it is subject to synchronization.
+func (s *syncer) relLocal(p string) (string, bool) {
+	rel, err := filepath.Rel(s.localRoot, p)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	rel = path.Clean("/" + filepath.ToSlash(rel))
+	return rel, s.synced(rel)
+}
+
+func (s *syncer) remotePath(rel string) string {
+	return path.Join(s.remoteRoot, rel)
+}
+
+func (s *syncer) localPath(rel string) string {
+	return filepath.Join(s.localRoot, filepath.FromSlash(rel))
+}
+
+// mountOf returns the mount that contains the given path.
+func (s *syncer) mountOf(rel string) (string, bool) {
+	for _, m := range s.mounts {
+		if rel == m || strings.HasPrefix(rel, m+"/") {
+			return m, true
+		}
+	}
+	return "", false
+}
+
+// synced returns true if the given path is in one of the mounts and neither the path nor
+// any of its parent directories in the mount are excluded.
+func (s *syncer) synced(rel string) bool {
+	m, ok := s.mountOf(rel)
+	if !ok {
+		return false
+	}
+	if strings.HasPrefix(path.Base(rel), syncTempPrefix) {
+		return false
+	}
+	for p := rel; p != m; p = path.Dir(p) {
+		if s.matchAny(s.exclude, m, p) {
+			return false
+		}
+	}
+	return true
+}
+
+// included returns true if a file with the given path is synchronized. Directories are
+// synchronized unless excluded, so that the files in them can be included.
+func (s *syncer) included(rel string) bool {
+	if len(s.include) == 0 {
+		return true
+	}
+	m, _ := s.mountOf(rel)
+	return s.matchAny(s.include, m, rel)
+}
+
+// matchAny returns true if any of the given globs matches the name of the path or the path
+// relative to its mount.
+func (s *syncer) matchAny(globs []string, mount, rel string) bool {
+	inMount := strings.TrimPrefix(strings.TrimPrefix(rel, mount), "/")
+	name := path.Base(rel)
+	for _, g := range globs {
+		if ok, _ := path.Match(g, name); ok {
+			return true
+		}
+		if ok, _ := path.Match(g, inMount); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *syncer) getBase(rel string) syncState {
+	if st, ok := s.base[path.Dir(rel)][path.Base(rel)]; ok {
+		return *st
+	}
+	return syncState{}
+}
+
+func (s *syncer) setBase(rel string, st syncState) {
+	if !st.local.exists && !st.remote.exists {
+		s.removeBase(rel)
+		return
+	}
+	dir := path.Dir(rel)
+	entries, ok := s.base[dir]
+	if !ok {
+		entries = make(map[string]*syncState)
+		s.base[dir] = entries
+	}
+	entries[path.Base(rel)] = &st
+}
+
+// removeBase removes the state of the given path and everything below it.
+func (s *syncer) removeBase(rel string) {
+	delete(s.base[path.Dir(rel)], path.Base(rel))
+	prefix := rel + "/"
+	for dir := range s.base {
+		if dir == rel || strings.HasPrefix(dir, prefix) {
+			delete(s.base, dir)
+		}
+	}
+}
+
+func (s *syncer) statLocal(rel string) (fileStat, error) {
+	fi, err := os.Stat(s.localPath(rel))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			err = nil
+		}
+		return fileStat{}, err
+	}
+	return localFileStat(fi), nil
+}
+
+func (s *syncer) statRemote(ctx context.Context, rel string) (fileStat, error) {
+	fi, err := s.getClient().Stat(ctx, &rpc.PathRequest{Path: s.remotePath(rel)})
+	if err != nil {
+		if err = fromStatus("stat", rel, err); errors.Is(err, fs.ErrNotExist) {
+			err = nil
+		}
+		return fileStat{}, err
+	}
+	return remoteFileStat(fi), nil
+}
+
+// syncAll synchronizes all mounts in full.
+func (s *syncer) syncAll(ctx context.Context) {
+	for _, m := range s.mounts {
+		if ctx.Err() != nil {
+			return
+		}
+		s.reconcile(ctx, m, -1)
+	}
+}
+
+// reconcile synchronizes the given path. The entries of directories are synchronized down
+// to the given depth, or all the way when the depth is negative.
+func (s *syncer) reconcile(ctx context.Context, rel string, depth int) {
+	r, err := s.statRemote(ctx, rel)
+	if err != nil {
+		dlog.Errorf(ctx, "unable to synchronize %s: %v", rel, err)
+		return
+	}
+	l, err := s.statLocal(rel)
+	if err != nil {
+		dlog.Errorf(ctx, "unable to synchronize %s: %v", rel, err)
+		return
+	}
+	s.reconcileWith(ctx, rel, r, l, depth)
+}
+
+// reconcileWith synchronizes the given path using its remote and local status. Directories
+// that are new on either side are synchronized in full.
+func (s *syncer) reconcileWith(ctx context.Context, rel string, r, l fileStat, depth int) {
+	if !s.synced(rel) || (!r.dir && !l.dir && !s.included(rel)) {
+		return
+	}
+	b := s.getBase(rel)
+	rChanged := !r.equal(b.remote)
+	lChanged := !l.equal(b.local)
+
+	var err error
+	switch {
+	case !rChanged && !lChanged:
+	case rChanged && !lChanged:
+		l, err = s.pull(ctx, rel, r, l)
+	case lChanged && !rChanged:
+		r, err = s.push(ctx, rel, l, r)
+	case r.sameContent(l):
+	case s.localWins(r, l):
+		dlog.Infof(ctx, "synchronization conflict for %s resolved using the local file", rel)
+		r, err = s.push(ctx, rel, l, r)
+	default:
+		dlog.Infof(ctx, "synchronization conflict for %s resolved using the remote file", rel)
+		l, err = s.pull(ctx, rel, r, l)
+	}
+	if err != nil {
+		dlog.Errorf(ctx, "unable to synchronize %s: %v", rel, err)
+		return
+	}
+	s.setBase(rel, syncState{local: l, remote: r})
+	if r.dir && l.dir {
+		if !(b.local.dir && b.remote.dir) {
+			depth = -1
+		}
+		if depth != 0 {
+			s.syncDir(ctx, rel, depth-1)
+		}
+	}
+}
+
+func (s *syncer) localWins(r, l fileStat) bool {
+	switch s.conflict {
+	case ConflictLocal:
+		return true
+	case ConflictRemote:
+		return false
+	}
+	// A deleted file never wins, so that no data is lost.
+	switch {
+	case !l.exists:
+		return false
+	case !r.exists:
+		return true
+	}
+	return l.modTime.After(r.modTime)
+}
+
+// syncDir synchronizes the entries of a directory that exists on both sides.
+func (s *syncer) syncDir(ctx context.Context, rel string, depth int) {
+	lp := s.localPath(rel)
+	if err := s.watcher.Add(lp); err != nil {
+		dlog.Errorf(ctx, "unable to watch %s: %v", lp, err)
+	}
+	des, err := s.getClient().ReadDir(ctx, &rpc.PathRequest{Path: s.remotePath(rel)})
+	if err != nil {
+		dlog.Errorf(ctx, "unable to synchronize %s: %v", rel, fromStatus("readdir", rel, err))
+		return
+	}
+	lfs, err := os.ReadDir(lp)
+	if err != nil {
+		dlog.Errorf(ctx, "unable to synchronize %s: %v", rel, err)
+		return
+	}
+
+	remote := make(map[string]fileStat, len(des.Entries))
+	local := make(map[string]fileStat, len(lfs))
+	names := make(map[string]struct{}, len(des.Entries))
+	for _, fi := range des.Entries {
+		remote[fi.Name] = remoteFileStat(fi)
+		names[fi.Name] = struct{}{}
+	}
+	for _, de := range lfs {
+		// Use Stat rather than the DirEntry's Info so that symbolic links are followed.
+		fi, err := os.Stat(filepath.Join(lp, de.Name()))
+		if err != nil {
+			continue
+		}
+		local[de.Name()] = localFileStat(fi)
+		names[de.Name()] = struct{}{}
+	}
+	// Entries that are gone from both sides still need to be removed from the base.
+	for name := range s.base[rel] {
+		names[name] = struct{}{}
+	}
+
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	for _, name := range sorted {
+		if ctx.Err() != nil {
+			return
+		}
+		s.reconcileWith(ctx, path.Join(rel, name), remote[name], local[name], depth)
+	}
+}
+
+// pull makes the local side equal to the remote side and returns the new local status.
+func (s *syncer) pull(ctx context.Context, rel string, r, l fileStat) (fileStat, error) {
+	lp := s.localPath(rel)
+	switch {
+	case !r.exists:
+		dlog.Debugf(ctx, "sync: removing local %s", rel)
+		if err := os.RemoveAll(lp); err != nil {
+			return l, err
+		}
+		s.removeBase(rel)
+		return fileStat{}, nil
+	case r.dir:
+		if l.exists && !l.dir {
+			if err := os.Remove(lp); err != nil {
+				return l, err
+			}
+		}
+		if err := os.MkdirAll(lp, r.mode.Perm()|0o700); err != nil {
+			return l, err
+		}
+	default:
+		dlog.Debugf(ctx, "sync: copying %s from the remote side", rel)
+		if l.dir {
+			if err := os.RemoveAll(lp); err != nil {
+				return l, err
+			}
+			s.removeBase(rel)
+		}
+		if err := s.download(ctx, rel, lp, r); err != nil {
+			return l, err
+		}
+	}
+	fi, err := os.Stat(lp)
+	if err != nil {
+		return l, err
+	}
+	return localFileStat(fi), nil
+}
+
+func (s *syncer) download(ctx context.Context, rel, lp string, r fileStat) error {
+	stream, err := s.getClient().Read(ctx, &rpc.ReadRequest{Path: s.remotePath(rel), ChunkSize: syncChunkSize})
+	if err != nil {
+		return fromStatus("read", rel, err)
+	}
+	f, err := os.CreateTemp(filepath.Dir(lp), syncTempPrefix)
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(tmp)
+	}()
+	for {
+		var c *rpc.Chunk
+		if c, err = stream.Recv(); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return fromStatus("read", rel, err)
+		}
+		if _, err = f.Write(c.Data); err != nil {
+			return err
+		}
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	if err = os.Chmod(tmp, r.mode.Perm()); err != nil {
+		return err
+	}
+	if err = os.Chtimes(tmp, r.modTime, r.modTime); err != nil {
+		return err
+	}
+	return os.Rename(tmp, lp)
+}
+
+// push makes the remote side equal to the local side and returns the new remote status.
+func (s *syncer) push(ctx context.Context, rel string, l, r fileStat) (fileStat, error) {
+	client := s.getClient()
+	rp := s.remotePath(rel)
+	switch {
+	case !l.exists:
+		dlog.Debugf(ctx, "sync: removing remote %s", rel)
+		if _, err := client.Remove(ctx, &rpc.RemoveRequest{Path: rp, Recursive: true}); err != nil {
+			if err = fromStatus("remove", rel, err); !errors.Is(err, fs.ErrNotExist) {
+				return r, err
+			}
+		}
+		s.removeBase(rel)
+		return fileStat{}, nil
+	case l.dir:
+		if r.exists && !r.dir {
+			if _, err := client.Remove(ctx, &rpc.RemoveRequest{Path: rp}); err != nil {
+				return r, fromStatus("remove", rel, err)
+			}
+		}
+		if !r.dir {
+			if _, err := client.Mkdir(ctx, &rpc.CreateRequest{Path: rp, Mode: uint32(l.mode.Perm())}); err != nil {
+				return r, fromStatus("mkdir", rel, err)
+			}
+		}
+	default:
+		dlog.Debugf(ctx, "sync: copying %s to the remote side", rel)
+		if r.dir {
+			if _, err := client.Remove(ctx, &rpc.RemoveRequest{Path: rp, Recursive: true}); err != nil {
+				return r, fromStatus("remove", rel, err)
+			}
+			s.removeBase(rel)
+		}
+		if err := s.upload(ctx, client, rel, rp, l); err != nil {
+			return r, err
+		}
+	}
+	fi, err := client.Stat(ctx, &rpc.PathRequest{Path: rp})
+	if err != nil {
+		return r, fromStatus("stat", rel, err)
+	}
+	return remoteFileStat(fi), nil
+}
+
+func (s *syncer) upload(ctx context.Context, client rpc.FileSystemClient, rel, rp string, l fileStat) error {
+	f, err := os.Open(s.localPath(rel))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	tmp := path.Join(path.Dir(rp), syncTempPrefix+path.Base(rp))
+	if _, err = client.Create(ctx, &rpc.CreateRequest{Path: tmp, Mode: uint32(l.mode.Perm())}); err != nil {
+		return fromStatus("create", rel, err)
+	}
+	ok := false
+	defer func() {
+		if !ok {
+			_, _ = client.Remove(ctx, &rpc.RemoveRequest{Path: tmp})
+		}
+	}()
+	buf := make([]byte, syncChunkSize)
+	var offset int64
+	for {
+		n, err := f.Read(buf)
+		if n > 0 {
+			if _, werr := client.Write(ctx, &rpc.WriteRequest{Path: tmp, Offset: offset, Data: buf[:n]}); werr != nil {
+				return fromStatus("write", rel, werr)
+			}
+			offset += int64(n)
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return err
+		}
+	}
+	mt := timestamppb.New(l.modTime)
+	if _, err = client.Chtimes(ctx, &rpc.ChtimesRequest{Path: tmp, AccessTime: mt, ModTime: mt}); err != nil {
+		return fromStatus("chtimes", rel, err)
+	}
+	if _, err = client.Rename(ctx, &rpc.RenameRequest{OldPath: tmp, NewPath: rp}); err != nil {
+		return fromStatus("rename", rel, err)
+	}
+	ok = true
+	return nil
+}
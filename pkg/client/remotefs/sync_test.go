@@ -0,0 +1,247 @@
+package remotefs
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/datawire/dlib/dlog"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/agent"
+	"github.com/telepresenceio/telepresence/rpc/v2/connector"
+)
+
+type memFile struct {
+	data    []byte
+	dir     bool
+	modTime time.Time
+}
+
+// memFsClient is an in-memory remote file system.
+type memFsClient struct {
+	rpc.FileSystemClient
+	sync.Mutex
+	files map[string]*memFile
+}
+
+func newMemFsClient(files map[string]string) *memFsClient {
+	c := &memFsClient{files: map[string]*memFile{"/": {dir: true}}}
+	for p, data := range files {
+		c.put(p, data, time.Now())
+	}
+	return c
+}
+
+func (c *memFsClient) put(p, data string, modTime time.Time) {
+	c.Lock()
+	defer c.Unlock()
+	for d := path.Dir(p); d != "/"; d = path.Dir(d) {
+		c.files[d] = &memFile{dir: true}
+	}
+	c.files[p] = &memFile{data: []byte(data), modTime: modTime}
+}
+
+func (c *memFsClient) get(p string) (string, bool) {
+	c.Lock()
+	defer c.Unlock()
+	f, ok := c.files[p]
+	if !ok {
+		return "", false
+	}
+	return string(f.data), true
+}
+
+func (c *memFsClient) infoLocked(p string) *rpc.FileInfo {
+	f := c.files[p]
+	mode := fs.FileMode(0o644)
+	if f.dir {
+		mode = fs.ModeDir | 0o755
+	}
+	return &rpc.FileInfo{Name: path.Base(p), Size: int64(len(f.data)), Mode: uint32(mode), ModTime: timestamppb.New(f.modTime)}
+}
+
+func (c *memFsClient) Stat(_ context.Context, in *rpc.PathRequest, _ ...grpc.CallOption) (*rpc.FileInfo, error) {
+	c.Lock()
+	defer c.Unlock()
+	if _, ok := c.files[in.Path]; !ok {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return c.infoLocked(in.Path), nil
+}
+
+func (c *memFsClient) ReadDir(_ context.Context, in *rpc.PathRequest, _ ...grpc.CallOption) (*rpc.DirEntries, error) {
+	c.Lock()
+	defer c.Unlock()
+	des := &rpc.DirEntries{}
+	for p := range c.files {
+		if p != "/" && path.Dir(p) == in.Path {
+			des.Entries = append(des.Entries, c.infoLocked(p))
+		}
+	}
+	return des, nil
+}
+
+func (c *memFsClient) Read(_ context.Context, in *rpc.ReadRequest, _ ...grpc.CallOption) (rpc.FileSystem_ReadClient, error) {
+	data, _ := c.get(in.Path)
+	return &fakeReadClient{data: []byte(data)}, nil
+}
+
+func (c *memFsClient) Create(_ context.Context, in *rpc.CreateRequest, _ ...grpc.CallOption) (*rpc.FileInfo, error) {
+	c.put(in.Path, "", time.Now())
+	return &rpc.FileInfo{Name: path.Base(in.Path)}, nil
+}
+
+func (c *memFsClient) Write(_ context.Context, in *rpc.WriteRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	c.Lock()
+	defer c.Unlock()
+	f := c.files[in.Path]
+	f.data = append(f.data[:in.Offset], in.Data...)
+	return &emptypb.Empty{}, nil
+}
+
+func (c *memFsClient) Mkdir(_ context.Context, in *rpc.CreateRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	c.Lock()
+	defer c.Unlock()
+	c.files[in.Path] = &memFile{dir: true}
+	return &emptypb.Empty{}, nil
+}
+
+func (c *memFsClient) Remove(_ context.Context, in *rpc.RemoveRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	c.Lock()
+	defer c.Unlock()
+	for p := range c.files {
+		if p == in.Path || strings.HasPrefix(p, in.Path+"/") {
+			delete(c.files, p)
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (c *memFsClient) Rename(_ context.Context, in *rpc.RenameRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	c.Lock()
+	defer c.Unlock()
+	c.files[in.NewPath] = c.files[in.OldPath]
+	delete(c.files, in.OldPath)
+	return &emptypb.Empty{}, nil
+}
+
+func (c *memFsClient) Chtimes(_ context.Context, in *rpc.ChtimesRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	c.Lock()
+	defer c.Unlock()
+	c.files[in.Path].modTime = in.ModTime.AsTime()
+	return &emptypb.Empty{}, nil
+}
+
+func newTestSyncer(t *testing.T, c *memFsClient, opts *connector.SyncOptions) (*syncer, string) {
+	local := t.TempDir()
+	s := newSyncer(c, "/app", local, []string{"/data"}, opts)
+	w, err := fsnotify.NewWatcher()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = w.Close() })
+	s.watcher = w
+	return s, local
+}
+
+func readLocal(t *testing.T, local, rel string) string {
+	data, err := os.ReadFile(filepath.Join(local, filepath.FromSlash(rel)))
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestSyncer(t *testing.T) {
+	ctx := dlog.NewTestContext(t, false)
+	c := newMemFsClient(map[string]string{
+		"/app/data/config.yaml":      "remote",
+		"/app/data/logs/app.log":     "log",
+		"/app/data/sub/index.js":     "js",
+		"/app/other/not-synced.yaml": "other",
+	})
+	s, local := newTestSyncer(t, c, &connector.SyncOptions{Exclude: []string{"logs"}})
+	require.NoError(t, os.MkdirAll(filepath.Join(local, "data"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(local, "data", "local.txt"), []byte("local"), 0o644))
+
+	// The initial synchronization copies in both directions.
+	s.syncAll(ctx)
+	assert.Equal(t, "remote", readLocal(t, local, "/data/config.yaml"))
+	assert.Equal(t, "js", readLocal(t, local, "/data/sub/index.js"))
+	data, ok := c.get("/app/data/local.txt")
+	assert.True(t, ok)
+	assert.Equal(t, "local", data)
+	assert.NoDirExists(t, filepath.Join(local, "data", "logs"))
+	assert.NoDirExists(t, filepath.Join(local, "other"))
+
+	// A remote change is pulled.
+	c.put("/app/data/config.yaml", "remote changed", time.Now())
+	s.reconcile(ctx, "/data/config.yaml", 1)
+	assert.Equal(t, "remote changed", readLocal(t, local, "/data/config.yaml"))
+
+	// A local change is pushed, and so is a local removal.
+	require.NoError(t, os.WriteFile(filepath.Join(local, "data", "sub", "index.js"), []byte("js changed"), 0o644))
+	require.NoError(t, os.Remove(filepath.Join(local, "data", "local.txt")))
+	s.reconcile(ctx, "/data", 2)
+	data, _ = c.get("/app/data/sub/index.js")
+	assert.Equal(t, "js changed", data)
+	_, ok = c.get("/app/data/local.txt")
+	assert.False(t, ok)
+
+	// A remote removal is pulled.
+	_, err := c.Remove(ctx, &rpc.RemoveRequest{Path: "/app/data/sub", Recursive: true})
+	require.NoError(t, err)
+	s.reconcile(ctx, "/data/sub", 1)
+	assert.NoDirExists(t, filepath.Join(local, "data", "sub"))
+}
+
+func TestSyncer_conflict(t *testing.T) {
+	ctx := dlog.NewTestContext(t, false)
+	older := time.Now().Add(-time.Hour)
+	for _, tc := range []struct {
+		rule   string
+		expect string
+	}{
+		{ConflictNewer, "local"},
+		{ConflictLocal, "local"},
+		{ConflictRemote, "remote"},
+	} {
+		t.Run(tc.rule, func(t *testing.T) {
+			c := newMemFsClient(map[string]string{"/app/data/a.txt": "remote"})
+			c.put("/app/data/a.txt", "remote", older)
+			s, local := newTestSyncer(t, c, &connector.SyncOptions{Conflict: tc.rule})
+			require.NoError(t, os.MkdirAll(filepath.Join(local, "data"), 0o755))
+			require.NoError(t, os.WriteFile(filepath.Join(local, "data", "a.txt"), []byte("local"), 0o644))
+			s.syncAll(ctx)
+			data, _ := c.get("/app/data/a.txt")
+			assert.Equal(t, tc.expect, data)
+			assert.Equal(t, tc.expect, readLocal(t, local, "/data/a.txt"))
+		})
+	}
+}
+
+func TestSyncer_synced(t *testing.T) {
+	s := newSyncer(nil, "/app", "/tmp", []string{"/data", "/var/run/secrets/"}, &connector.SyncOptions{
+		Include: []string{"*.go", "cmd/*"},
+		Exclude: []string{"vendor", "*.log"},
+	})
+	assert.True(t, s.synced("/data"))
+	assert.True(t, s.synced("/var/run/secrets/token"))
+	assert.False(t, s.synced("/other/main.go"))
+	assert.False(t, s.synced("/data/vendor/x/y.go"))
+	assert.False(t, s.synced("/data/app.log"))
+	assert.False(t, s.synced("/data/"+syncTempPrefix+"123"))
+	assert.True(t, s.included("/data/pkg/main.go"))
+	assert.True(t, s.included("/data/cmd/run"))
+	assert.False(t, s.included("/data/README.md"))
+}
@@ -0,0 +1,74 @@
+package remotefs
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/datawire/dlib/dlog"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/agent"
+	"github.com/telepresenceio/telepresence/rpc/v2/connector"
+	"github.com/telepresenceio/telepresence/v2/pkg/agentconfig"
+	"github.com/telepresenceio/telepresence/v2/pkg/iputil"
+)
+
+// syncMounter doesn't mount anything. It synchronizes the mounts of the intercepted container
+// with the client mount point using the FileSystem gRPC service of the traffic-agent.
+type syncMounter struct {
+	opts   *connector.SyncOptions
+	mounts []string
+	syncer *syncer
+	conn   *grpc.ClientConn
+}
+
+// NewSyncMounter returns a Mounter that synchronizes the given mounts, which are relative to
+// the container's mount point, with the client mount point.
+func NewSyncMounter(opts *connector.SyncOptions, mounts []string) Mounter {
+	return &syncMounter{opts: opts, mounts: mounts}
+}
+
+func (m *syncMounter) Start(ctx context.Context, id, clientMountPoint, mountPoint string, podIP net.IP, port uint16) error {
+	// Like the gRPC mounter, the synchronization is controlled by the intercept context and survives pod changes.
+	addr := iputil.JoinIpPort(podIP, port)
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	fsClient := rpc.NewFileSystemClient(conn)
+
+	if m.syncer != nil {
+		// Switch to the new pod. The ongoing watch of remote changes breaks when the old
+		// connection closes, and a full synchronization starts when it is reestablished.
+		dlog.Infof(ctx, "Switching remote address to %s for synchronization for intercept %q at %q", addr, id, clientMountPoint)
+		m.syncer.setClient(fsClient)
+		m.setConn(conn)
+		return nil
+	}
+
+	dlog.Infof(ctx, "Synchronizing %s for intercept %q (address %s) with %q", strings.Join(m.mounts, ", "), id, addr, clientMountPoint)
+	m.syncer = newSyncer(fsClient, strings.TrimPrefix(mountPoint, agentconfig.ExportsMountPoint), clientMountPoint, m.mounts, m.opts)
+	m.setConn(conn)
+	go func() {
+		if err := m.syncer.run(ctx); err != nil {
+			dlog.Errorf(ctx, "synchronization for intercept %q failed: %v", id, err)
+		}
+		m.setConn(nil)
+	}()
+	return nil
+}
+
+// setConn replaces the connection to the traffic-agent and closes the previous one. The connection
+// is guarded by the syncer's mutex, because the goroutine that runs the syncer closes it when the
+// synchronization ends.
+func (m *syncMounter) setConn(conn *grpc.ClientConn) {
+	m.syncer.Lock()
+	old := m.conn
+	m.conn = conn
+	m.syncer.Unlock()
+	if old != nil {
+		_ = old.Close()
+	}
+}
@@ -0,0 +1,96 @@
+package rootd
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/daemon"
+	"github.com/telepresenceio/telepresence/v2/pkg/ipproto"
+	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
+)
+
+// connTracker keeps track of the streams that are created for connections that are routed to the
+// cluster through the TUN device.
+type connTracker struct {
+	sync.Mutex
+	streams map[*trackedStream]struct{}
+}
+
+// trackedStream is a tunnel.Stream that counts the payload bytes that it sends and receives.
+type trackedStream struct {
+	tunnel.Stream
+	started  time.Time
+	sent     uint64
+	received uint64
+}
+
+func newConnTracker() *connTracker {
+	return &connTracker{streams: make(map[*trackedStream]struct{})}
+}
+
+// track returns a stream that is tracked until the given context is cancelled. The context is
+// the one that the connection endpoint cancels when the connection ends.
+func (t *connTracker) track(ctx context.Context, s tunnel.Stream) tunnel.Stream {
+	ts := &trackedStream{Stream: s, started: time.Now()}
+	t.Lock()
+	t.streams[ts] = struct{}{}
+	t.Unlock()
+	go func() {
+		<-ctx.Done()
+		t.Lock()
+		delete(t.streams, ts)
+		t.Unlock()
+	}()
+	return ts
+}
+
+// snapshot returns the currently tracked connections, oldest first. The nameOf function is used
+// to find the service name of each destination.
+func (t *connTracker) snapshot(nameOf func(tunnel.ConnID) string) *rpc.Connections {
+	t.Lock()
+	streams := make([]*trackedStream, 0, len(t.streams))
+	for ts := range t.streams {
+		streams = append(streams, ts)
+	}
+	t.Unlock()
+	sort.Slice(streams, func(i, j int) bool {
+		return streams[i].started.Before(streams[j].started)
+	})
+
+	now := time.Now()
+	cs := make([]*rpc.Connection, len(streams))
+	for i, ts := range streams {
+		id := ts.ID()
+		cs[i] = &rpc.Connection{
+			Protocol:      ipproto.String(id.Protocol()),
+			Source:        id.SourceAddr().String(),
+			Destination:   id.DestinationAddr().String(),
+			BytesSent:     atomic.LoadUint64(&ts.sent),
+			BytesReceived: atomic.LoadUint64(&ts.received),
+			Age:           durationpb.New(now.Sub(ts.started)),
+			ServiceName:   nameOf(id),
+		}
+	}
+	return &rpc.Connections{Connections: cs}
+}
+
+func (s *trackedStream) Send(ctx context.Context, m tunnel.Message) error {
+	err := s.Stream.Send(ctx, m)
+	if err == nil && m.Code() == tunnel.Normal {
+		atomic.AddUint64(&s.sent, uint64(len(m.Payload())))
+	}
+	return err
+}
+
+func (s *trackedStream) Receive(ctx context.Context) (tunnel.Message, error) {
+	m, err := s.Stream.Receive(ctx)
+	if err == nil && m.Code() == tunnel.Normal {
+		atomic.AddUint64(&s.received, uint64(len(m.Payload())))
+	}
+	return m, err
+}
@@ -372,6 +372,41 @@ func (s *Server) RequestCount() int {
 	return int(atomic.LoadInt64(&s.requestCount))
 }
 
+// NameOf returns the name of a cached query that resolved to the given IP, or an empty string if there is
+// no such query. The shortest name is returned when several names resolved to the IP.
+func (s *Server) NameOf(ip net.IP) string {
+	name := ""
+	s.cache.Range(func(key, value any) bool {
+		dv := value.(*cacheEntry)
+		select {
+		case <-dv.wait:
+		default:
+			// Still resolving
+			return true
+		}
+		if dv.expired() {
+			return true
+		}
+		for _, rr := range dv.answer {
+			var rrIP net.IP
+			switch rr := rr.(type) {
+			case *dns.A:
+				rrIP = rr.A
+			case *dns.AAAA:
+				rrIP = rr.AAAA
+			}
+			if rrIP.Equal(ip) {
+				if qn := strings.TrimSuffix(key.(cacheKey).name, "."); name == "" || len(qn) < len(name) {
+					name = qn
+				}
+				break
+			}
+		}
+		return true
+	})
+	return name
+}
+
 func copyRRs(rrs dnsproxy.RRs, qType uint16) dnsproxy.RRs {
 	if len(rrs) == 0 {
 		return rrs
@@ -447,6 +482,57 @@ func (s *Server) resolveWithRecursionCheck(q *dns.Question) (dnsproxy.RRs, int,
 	return answer, rCode, err
 }
 
+// RecursionChecked returns true when the recursion check has completed, and whether the check found
+// that queries that this server propagates to the cluster recurse back to it.
+func (s *Server) RecursionChecked() (checked, recursive bool) {
+	switch atomic.LoadInt32(&s.recursive) {
+	case recursionNotDetected:
+		return true, false
+	case recursionDetected:
+		return true, true
+	default:
+		return false, false
+	}
+}
+
+// LookupService resolves the given service name, in the form <name>.<namespace>, in the cluster domain
+// using the cache and resolver that serve the queries that this server receives. The fully qualified name
+// is returned together with the addresses that it resolved to.
+func (s *Server) LookupService(serviceName string) (string, []net.IP, error) {
+	name := serviceName + ".svc." + s.clusterDomain
+	if s.resolve == nil {
+		return name, nil, errors.New("the DNS server is not running")
+	}
+	var ips []net.IP
+	var err error
+	for _, qType := range []uint16{dns.TypeA, dns.TypeAAAA} {
+		if s.onlyNames && qType != dns.TypeA {
+			break
+		}
+		answer, rCode, qErr := s.cacheResolve(&dns.Question{Name: name, Qtype: qType, Qclass: dns.ClassINET})
+		if qErr == nil && rCode != dns.RcodeSuccess {
+			qErr = fmt.Errorf("%s: %s", name, dns.RcodeToString[rCode])
+		}
+		if qErr != nil {
+			// A service might have addresses of one type only.
+			err = qErr
+			continue
+		}
+		for _, rr := range answer {
+			switch rr := rr.(type) {
+			case *dns.A:
+				ips = append(ips, rr.A)
+			case *dns.AAAA:
+				ips = append(ips, rr.AAAA)
+			}
+		}
+	}
+	if len(ips) > 0 {
+		err = nil
+	}
+	return name, ips, err
+}
+
 // dfs is a func that implements the fmt.Stringer interface. Used in log statements to ensure
 // that the function isn't evaluated until the log output is formatted (which will happen only
 // if the given loglevel is enabled).
@@ -95,6 +95,15 @@ func (rd *InProcSession) WaitForNetwork(ctx context.Context, in *empty.Empty, op
 	return &empty.Empty{}, nil
 }
 
+func (rd *InProcSession) WatchConnections(ctx context.Context, in *rpc.WatchConnectionsRequest, opts ...grpc.CallOption) (rpc.Daemon_WatchConnectionsClient, error) {
+	// Connections are inspected using the root daemon's gRPC service, which isn't running when the session is in-process.
+	return nil, status.Error(codes.Unimplemented, "WatchConnections is not available when the session runs in the user daemon")
+}
+
+func (rd *InProcSession) CheckDNS(ctx context.Context, in *rpc.CheckDNSRequest, opts ...grpc.CallOption) (*rpc.CheckDNSResponse, error) {
+	return rd.checkDNS(in.ServiceName), nil
+}
+
 // NewInProcSession returns a root daemon session suitable to use in-process (from the user daemon) and is primarily intended for
 // when the user daemon runs in a docker container with NET_ADMIN capabilities.
 func NewInProcSession(
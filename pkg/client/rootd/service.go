@@ -234,6 +234,42 @@ func (s *Service) GetNetworkConfig(ctx context.Context, e *empty.Empty) (nc *rpc
 	return
 }
 
+func (s *Service) WatchConnections(request *rpc.WatchConnectionsRequest, stream rpc.Daemon_WatchConnectionsServer) error {
+	interval := request.Interval.AsDuration()
+	if interval <= 0 {
+		interval = time.Second
+	}
+	ctx := stream.Context()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		var cs *rpc.Connections
+		err := s.WithSession(func(_ context.Context, session *Session) error {
+			cs = session.Connections()
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if err = stream.Send(cs); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) CheckDNS(_ context.Context, request *rpc.CheckDNSRequest) (r *rpc.CheckDNSResponse, err error) {
+	err = s.WithSession(func(_ context.Context, session *Session) error {
+		r = session.checkDNS(request.ServiceName)
+		return nil
+	})
+	return r, err
+}
+
 func (s *Service) SetLogLevel(ctx context.Context, request *manager.LogLevelRequest) (*empty.Empty, error) {
 	duration := time.Duration(0)
 	if request.Duration != nil {
@@ -91,6 +91,9 @@ type Session struct {
 	// are obtained using a connpool.ConnID.
 	handlers *tunnel.Pool
 
+	// connections tracks the streams of the connections that are routed to the cluster
+	connections *connTracker
+
 	// fragmentMap is when concatenating ipv4 fragments
 	fragmentMap map[uint16][]*buffer.Data
 
@@ -253,6 +256,7 @@ func newSession(c context.Context, scout *scout.Reporter, mi *rpc.OutboundInfo,
 	s := &Session{
 		scout:            scout,
 		handlers:         tunnel.NewPool(),
+		connections:      newConnTracker(),
 		fragmentMap:      make(map[uint16][]*buffer.Data),
 		rndSource:        rand.NewSource(time.Now().UnixNano()),
 		session:          mi.Session,
@@ -826,3 +830,33 @@ func (s *Session) applyConfig(ctx context.Context) error {
 func (s *Session) Done() chan struct{} {
 	return s.done
 }
+
+// checkDNS resolves the given service name using the session's DNS server, and reports the outcome
+// of the DNS server's recursion check.
+func (s *Session) checkDNS(serviceName string) *rpc.CheckDNSResponse {
+	r := &rpc.CheckDNSResponse{}
+	switch checked, recursive := s.dnsServer.RecursionChecked(); {
+	case !checked:
+		r.Recursion = rpc.CheckDNSResponse_IN_PROGRESS
+	case recursive:
+		r.Recursion = rpc.CheckDNSResponse_DETECTED
+	default:
+		r.Recursion = rpc.CheckDNSResponse_NOT_DETECTED
+	}
+	name, ips, err := s.dnsServer.LookupService(serviceName)
+	r.Name = name
+	if err != nil {
+		r.Error = err.Error()
+	}
+	for _, ip := range ips {
+		r.Ips = append(r.Ips, ip)
+	}
+	return r
+}
+
+// Connections returns a snapshot of the connections that are currently routed to the cluster.
+func (s *Session) Connections() *rpc.Connections {
+	return s.connections.snapshot(func(id tunnel.ConnID) string {
+		return s.dnsServer.NameOf(id.Destination())
+	})
+}
@@ -293,7 +293,13 @@ func (s *Service) RemoveIntercept(c context.Context, rr *manager.RemoveIntercept
 			result.ServiceUid = spec.ServiceUid
 			result.WorkloadKind = spec.WorkloadKind
 		}
-		if err := session.RemoveIntercept(c, rr.Name); err != nil {
+		var err error
+		if drain := rr.DrainTimeout.AsDuration(); drain > 0 {
+			err = session.DrainIntercept(c, rr.Name, drain)
+		} else {
+			err = session.RemoveIntercept(c, rr.Name)
+		}
+		if err != nil {
 			if status.Code(err) == codes.NotFound {
 				result.Error = common.InterceptError_NOT_FOUND
 				result.ErrorText = rr.Name
@@ -312,6 +318,9 @@ func (s *Service) RemoveIntercept(c context.Context, rr *manager.RemoveIntercept
 
 func (s *Service) UpdateIntercept(c context.Context, rr *manager.UpdateInterceptRequest) (result *manager.InterceptInfo, err error) {
 	err = s.WithSession(c, "UpdateIntercept", func(c context.Context, session userd.Session) error {
+		if rr.Session == nil {
+			rr.Session = session.SessionInfo()
+		}
 		result, err = session.ManagerClient().UpdateIntercept(c, rr)
 		return err
 	})
@@ -512,7 +521,7 @@ func (s *Service) RemoteMountAvailability(ctx context.Context, _ *empty.Empty) (
 		// We mount using docker volumes and the telemount driver plugin.
 		return errcat.ToResult(nil), nil
 	}
-	if client.GetConfig(ctx).Intercept.UseFtp {
+	if client.GetConfig(ctx).Intercept.UsesFuseFTP() {
 		return errcat.ToResult(s.FuseFTPError()), nil
 	}
 
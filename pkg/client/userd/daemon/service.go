@@ -450,7 +450,7 @@ func run(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	if cfg.Intercept.UseFtp {
+	if cfg.Intercept.UsesFuseFTP() {
 		g.Go("fuseftp-server", s.fuseFtpMgr.DeferInit)
 	}
 
@@ -11,6 +11,7 @@ import (
 	"github.com/blang/semver"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/datawire/dlib/dlog"
@@ -18,6 +19,7 @@ import (
 	"github.com/datawire/k8sapi/pkg/k8sapi"
 	"github.com/telepresenceio/telepresence/v2/pkg/client"
 	"github.com/telepresenceio/telepresence/v2/pkg/client/userd"
+	"github.com/telepresenceio/telepresence/v2/pkg/workload"
 )
 
 const supportedKubeAPIVersion = "1.17.0"
@@ -30,6 +32,9 @@ type Cluster struct {
 	// Main
 	ki kubernetes.Interface
 
+	// Dynamic interface, used when accessing custom resources such as Argo Rollouts
+	di dynamic.Interface
+
 	// Current Namespace snapshot, get set by namespace Watcher.
 	// The boolean value indicates if this client is allowed to
 	// watch services and retrieve workloads in the namespace
@@ -127,6 +132,11 @@ func NewCluster(c context.Context, kubeFlags *client.Kubeconfig, namespaces []st
 		return nil, err
 	}
 	c = k8sapi.WithK8sInterface(c, cs)
+	di, err := dynamic.NewForConfig(rs)
+	if err != nil {
+		return nil, err
+	}
+	c = workload.WithDynamicInterface(c, di)
 
 	if len(namespaces) == 1 && namespaces[0] == "all" {
 		namespaces = nil
@@ -138,6 +148,7 @@ func NewCluster(c context.Context, kubeFlags *client.Kubeconfig, namespaces []st
 		Kubeconfig:       kubeFlags,
 		mappedNamespaces: namespaces,
 		ki:               cs,
+		di:               di,
 	}
 
 	timedC, cancel := client.GetConfig(c).Timeouts.TimeoutContext(c, client.TimeoutClusterConnect)
@@ -182,5 +193,5 @@ func (kc *Cluster) GetClusterId(ctx context.Context) string {
 }
 
 func (kc *Cluster) WithK8sInterface(c context.Context) context.Context {
-	return k8sapi.WithK8sInterface(c, kc.ki)
+	return workload.WithDynamicInterface(k8sapi.WithK8sInterface(c, kc.ki), kc.di)
 }
@@ -2,6 +2,7 @@ package userd
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/grpc"
 	core "k8s.io/api/core/v1"
@@ -51,6 +52,7 @@ type Session interface {
 	InterceptProlog(context.Context, *manager.CreateInterceptRequest) *rpc.InterceptResult
 	InterceptEpilog(context.Context, *rpc.CreateInterceptRequest, *rpc.InterceptResult) *rpc.InterceptResult
 	RemoveIntercept(context.Context, string) error
+	DrainIntercept(context.Context, string, time.Duration) error
 
 	AddInterceptor(string, int) error
 	RemoveInterceptor(string) error
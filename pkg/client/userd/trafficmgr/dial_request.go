@@ -3,6 +3,9 @@ package trafficmgr
 import (
 	"context"
 
+	"github.com/datawire/dlib/dlog"
+	"github.com/telepresenceio/telepresence/v2/pkg/iputil"
+	"github.com/telepresenceio/telepresence/v2/pkg/recording"
 	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
 )
 
@@ -16,5 +19,47 @@ func (s *session) _dialRequestWatcher(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	ctx = tunnel.WithStreamWrapper(ctx, s.recordStream)
 	return tunnel.DialWaitLoop(ctx, s.managerClient, dialerStream, s.sessionInfo.SessionId)
 }
+
+// recordStream wraps the given stream in a recording stream when its destination is the target of
+// an intercept that records its traffic. The health probes of the traffic-agent are not recorded.
+func (s *session) recordStream(ctx context.Context, st tunnel.Stream) (tunnel.Stream, func()) {
+	id := st.ID()
+	if id.IsProbe() {
+		return st, func() {}
+	}
+	ic := s.recordingIntercept(id)
+	if ic == nil {
+		return st, func() {}
+	}
+	w, err := recording.Create(ic.recordDir, id, ic.Spec.Name)
+	if err != nil {
+		dlog.Errorf(ctx, "unable to record %s: %v", id, err)
+		return st, func() {}
+	}
+	dlog.Debugf(ctx, "recording %s to %s", id, w.Name())
+	return recording.NewStream(st, w), func() {
+		if err := w.Close(); err != nil {
+			dlog.Errorf(ctx, "recording of %s to %s failed: %v", id, w.Name(), err)
+		}
+	}
+}
+
+// recordingIntercept returns the recording intercept that targets the destination of the given id,
+// or nil if no such intercept exists.
+func (s *session) recordingIntercept(id tunnel.ConnID) *intercept {
+	s.currentInterceptsLock.Lock()
+	defer s.currentInterceptsLock.Unlock()
+	for _, ic := range s.currentIntercepts {
+		if ic.recordDir == "" || ic.Spec.TargetPort != int32(id.DestinationPort()) {
+			continue
+		}
+		if ip := iputil.Parse(ic.Spec.TargetHost); ip != nil && !ip.Equal(id.Destination()) {
+			continue
+		}
+		return ic
+	}
+	return nil
+}
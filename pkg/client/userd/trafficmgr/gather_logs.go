@@ -74,15 +74,7 @@ func getPodLog(ctx context.Context, exportDir string, result *sync.Map, podsAPI
 
 func (s *session) ForeachAgentPod(ctx context.Context, fn func(context.Context, typed.PodInterface, *core.Pod), filter func(*core.Pod) bool) error {
 	hasContainer := func(pod *core.Pod) bool {
-		if filter == nil || filter(pod) {
-			cns := pod.Spec.Containers
-			for c := range cns {
-				if cns[c].Name == agentconfig.ContainerName {
-					return true
-				}
-			}
-		}
-		return false
+		return (filter == nil || filter(pod)) && agentconfig.FindAgentContainer(&pod.Spec) != nil
 	}
 
 	coreAPI := k8sapi.GetK8sInterface(ctx).CoreV1()
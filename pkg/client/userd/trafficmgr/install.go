@@ -231,11 +231,9 @@ func ensureInjectedAgent(
 nextPod:
 	for _, pod := range pods {
 		podImpl, _ := k8sapi.PodImpl(pod)
-		for _, container := range podImpl.Spec.Containers {
-			if container.Name == install.AgentContainerName {
-				roll = false
-				break nextPod
-			}
+		if agentconfig.FindAgentContainer(&podImpl.Spec) != nil {
+			roll = false
+			break nextPod
 		}
 	}
 	if roll {
@@ -15,6 +15,7 @@ import (
 	"github.com/blang/semver"
 	grpcCodes "google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	empty "google.golang.org/protobuf/types/known/emptypb"
 	core "k8s.io/api/core/v1"
 	errors2 "k8s.io/apimachinery/pkg/api/errors"
@@ -65,6 +66,12 @@ type intercept struct {
 
 	// Use bridged ftp/sftp mount through this local port
 	localMountPort int32
+
+	// recordDir is the directory where the traffic of intercepted connections is recorded.
+	recordDir string
+
+	// syncOptions, when set, makes the ClientMountPoint a synchronized copy of the remote mounts.
+	syncOptions *rpc.SyncOptions
 }
 
 // interceptResult is what gets written to the awaitIntercept's waitCh channel when the
@@ -85,6 +92,12 @@ type awaitIntercept struct {
 	// the mount to take place in a host
 	mountPort int32
 
+	// recordDir is optional and is the directory where intercepted connections are recorded
+	recordDir string
+
+	// syncOptions is optional and indicates that the mounts should be synchronized rather than mounted
+	syncOptions *rpc.SyncOptions
+
 	waitCh chan<- interceptResult
 }
 
@@ -282,7 +295,9 @@ func (s *session) handleInterceptSnapshot(ctx context.Context, podIcepts *podInt
 		s.currentInterceptsLock.Unlock()
 
 		var err error
-		if ii.Disposition == manager.InterceptDispositionType_ACTIVE {
+		switch ii.Disposition {
+		case manager.InterceptDispositionType_ACTIVE, manager.InterceptDispositionType_PAUSED, manager.InterceptDispositionType_DRAINING:
+			// Paused and draining intercepts retain their mounts and port-forwards.
 			active++
 			ns := ii.Spec.Namespace
 			if ins == "" {
@@ -290,7 +305,7 @@ func (s *session) handleInterceptSnapshot(ctx context.Context, podIcepts *podInt
 			} else if ins != ns {
 				err = errcat.User.Newf("active intercepts in both namespace %s and %s", ns, ins)
 			}
-		} else {
+		default:
 			err = fmt.Errorf("intercept in error state %v: %v", ii.Disposition, ii.Message)
 		}
 
@@ -314,6 +329,7 @@ func (s *session) handleInterceptSnapshot(ctx context.Context, podIcepts *podInt
 			// disable mount point logic
 			ic.FtpPort = 0
 			ic.SftpPort = 0
+			ic.GrpcFsPort = 0
 		}
 		podIcepts.start(ctx, ic)
 	}
@@ -363,6 +379,8 @@ func (s *session) setCurrentIntercepts(ctx context.Context, iis []*manager.Inter
 			if aw, ok := s.interceptWaiters[ii.Spec.Name]; ok {
 				ic.ClientMountPoint = aw.mountPoint
 				ic.localMountPort = aw.mountPort
+				ic.recordDir = aw.recordDir
+				ic.syncOptions = aw.syncOptions
 			}
 		}
 		intercepts[ii.Id] = ic
@@ -649,9 +667,11 @@ func AddIntercept(sif userd.Session, c context.Context, ir *rpc.CreateInterceptR
 	waitCh := make(chan interceptResult, 2) // Need a buffer because reply can come before we're reading the channel,
 	s.currentInterceptsLock.Lock()
 	s.interceptWaiters[spec.Name] = &awaitIntercept{
-		mountPoint: ir.MountPoint,
-		mountPort:  ir.LocalMountPort,
-		waitCh:     waitCh,
+		mountPoint:  ir.MountPoint,
+		mountPort:   ir.LocalMountPort,
+		recordDir:   ir.RecordDir,
+		syncOptions: ir.Sync,
+		waitCh:      waitCh,
 	}
 	s.currentInterceptsLock.Unlock()
 	defer func() {
@@ -670,6 +690,10 @@ func AddIntercept(sif userd.Session, c context.Context, ir *rpc.CreateInterceptR
 	})
 	if err != nil {
 		dlog.Debugf(c, "manager responded to CreateIntercept with error %v", err)
+		if st, ok := grpcStatus.FromError(err); ok && st.Code() == grpcCodes.PermissionDenied {
+			// Denied by the intercept policy of the traffic-manager
+			err = errcat.User.New(st.Message())
+		}
 		return InterceptError(common.InterceptError_TRAFFIC_MANAGER_ERROR, err)
 	}
 
@@ -748,7 +772,13 @@ func waitForDNS(c context.Context, host string) bool {
 // RemoveIntercept removes one intercept by name.
 func (s *session) RemoveIntercept(c context.Context, name string) error {
 	dlog.Debugf(c, "Removing intercept %s", name)
+	return s.DrainIntercept(c, name, 0)
+}
 
+// DrainIntercept removes one intercept by name once the connections that are tunneled to
+// this client have closed, or when the given timeout has passed. New connections are sent
+// to the intercepted container in the meantime. A zero timeout removes the intercept at once.
+func (s *session) DrainIntercept(c context.Context, name string, timeout time.Duration) error {
 	if _, ok := s.localIntercepts[name]; ok {
 		return s.RemoveLocalOnlyIntercept(c, name)
 	}
@@ -758,28 +788,46 @@ func (s *session) RemoveIntercept(c context.Context, name string) error {
 		dlog.Debugf(c, "Intercept %s was already removed", name)
 		return nil
 	}
-	return s.removeIntercept(c, ii)
+	return s.removeIntercept(c, ii, timeout)
 }
 
-func (s *session) removeIntercept(c context.Context, ic *intercept) error {
+func (s *session) removeIntercept(c context.Context, ic *intercept, drainTimeout time.Duration) error {
 	name := ic.Spec.Name
-	if ic.pid != 0 {
-		p, err := os.FindProcess(ic.pid)
-		if err != nil {
-			dlog.Errorf(c, "unable to find interceptor for intercept %s with pid %d", name, ic.pid)
-		} else {
-			dlog.Debugf(c, "terminating interceptor for intercept %s with pid %d", name, ic.pid)
-			_ = proc.Terminate(p)
+	terminate := func() {
+		if ic.pid != 0 {
+			p, err := os.FindProcess(ic.pid)
+			if err != nil {
+				dlog.Errorf(c, "unable to find interceptor for intercept %s with pid %d", name, ic.pid)
+			} else {
+				dlog.Debugf(c, "terminating interceptor for intercept %s with pid %d", name, ic.pid)
+				_ = proc.Terminate(p)
+			}
 		}
 	}
 
-	dlog.Debugf(c, "telling manager to remove intercept %s", name)
-	c, cancel := client.GetConfig(c).Timeouts.TimeoutContext(c, client.TimeoutTrafficManagerAPI)
-	defer cancel()
-	_, err := s.managerClient.RemoveIntercept(c, &manager.RemoveInterceptRequest2{
+	rq := &manager.RemoveInterceptRequest2{
 		Session: s.SessionInfo(),
 		Name:    name,
-	})
+	}
+	if drainTimeout > 0 {
+		// The interceptor must serve the draining connections.
+		defer terminate()
+		rq.DrainTimeout = durationpb.New(drainTimeout)
+		dlog.Debugf(c, "telling manager to drain and remove intercept %s", name)
+	} else {
+		terminate()
+		dlog.Debugf(c, "telling manager to remove intercept %s", name)
+	}
+	timeouts := client.GetConfig(c).Timeouts
+	var cancel context.CancelFunc
+	if drainTimeout > 0 {
+		// The call returns when the intercept is drained.
+		c, cancel = context.WithTimeout(c, timeouts.Get(client.TimeoutTrafficManagerAPI)+drainTimeout)
+	} else {
+		c, cancel = timeouts.TimeoutContext(c, client.TimeoutTrafficManagerAPI)
+	}
+	defer cancel()
+	_, err := s.managerClient.RemoveIntercept(c, rq)
 	return err
 }
 
@@ -856,7 +904,7 @@ func (s *session) InterceptsForWorkload(workloadName, namespace string) []*manag
 func (s *session) ClearIntercepts(c context.Context) error {
 	for _, ic := range s.getCurrentIntercepts() {
 		dlog.Debugf(c, "Clearing intercept %s", ic.Spec.Name)
-		err := s.removeIntercept(c, ic)
+		err := s.removeIntercept(c, ic, 0)
 		if err != nil && grpcStatus.Code(err) != grpcCodes.NotFound {
 			return err
 		}
@@ -884,7 +932,8 @@ func (s *session) reconcileAPIServers(ctx context.Context) {
 
 	for _, ic := range s.currentIntercepts {
 		ii := ic.InterceptInfo
-		if ic.Disposition == manager.InterceptDispositionType_ACTIVE {
+		switch ic.Disposition {
+		case manager.InterceptDispositionType_ACTIVE, manager.InterceptDispositionType_PAUSED, manager.InterceptDispositionType_DRAINING:
 			if port := agentAPIPort(ii); port > 0 {
 				wantedPorts[port] = struct{}{}
 				wantedMatchers[ic.Id] = ii
@@ -2,10 +2,12 @@ package trafficmgr
 
 import (
 	"context"
+	"strings"
 	"sync"
 
 	"github.com/datawire/dlib/dlog"
 	"github.com/datawire/go-fuseftp/rpc"
+	"github.com/telepresenceio/telepresence/v2/pkg/agentconfig"
 	"github.com/telepresenceio/telepresence/v2/pkg/client"
 	"github.com/telepresenceio/telepresence/v2/pkg/client/remotefs"
 	"github.com/telepresenceio/telepresence/v2/pkg/client/userd"
@@ -13,17 +15,45 @@ import (
 )
 
 func (ic *intercept) shouldMount() bool {
-	return (ic.FtpPort > 0 || ic.SftpPort > 0) && (ic.localMountPort > 0 || ic.ClientMountPoint != "")
+	return (ic.FtpPort > 0 || ic.SftpPort > 0 || ic.GrpcFsPort > 0) && (ic.localMountPort > 0 || ic.ClientMountPoint != "")
 }
 
 // startMount starts the mount for the given podInterceptKey.
 // It assumes that the user has called shouldMount and is sure that something will be started.
 func (ic *intercept) startMount(ctx context.Context, podWG *sync.WaitGroup) {
 	var fuseftp rpc.FuseFTPClient
-	useFtp := client.GetConfig(ctx).Intercept.UseFtp
+	cfg := client.GetConfig(ctx).Intercept
+	useSync := ic.syncOptions != nil
+	useGrpcFs := cfg.UseGrpcFs && !useSync
+	useFtp := cfg.UseFtp && !useGrpcFs && !useSync
 	var port int32
 	mountCtx := ctx
-	if useFtp {
+	switch {
+	case useSync:
+		if ic.GrpcFsPort == 0 {
+			dlog.Errorf(ctx, "Intercept uses --mount-mode=sync, but the traffic-agent doesn't provide a gRPC file service")
+			return
+		}
+		// Like the gRPC mounter, the synchronization survives multiple starts for the same intercept.
+		mountCtx = ic.ctx
+		port = ic.GrpcFsPort
+	case useGrpcFs:
+		if ic.GrpcFsPort == 0 {
+			dlog.Errorf(ctx, "Client is configured to perform remote mounts using gRPC, but the traffic-agent doesn't provide a gRPC file service")
+			return
+		}
+		if ic.localMountPort > 0 {
+			dlog.Errorf(ctx, "Client is configured to perform remote mounts using gRPC, but only SFTP can be used with --local-mount-port")
+			return
+		}
+		// Like the FTP mounter, the gRPC mounter survives multiple starts for the same intercept.
+		mountCtx = ic.ctx
+		if fuseftp = userd.GetService(ctx).FuseFTPMgr().GetFuseFTPClient(ctx); fuseftp == nil {
+			dlog.Errorf(ctx, "Client is configured to perform remote mounts using gRPC, but the fuseftp server was unable to start")
+			return
+		}
+		port = ic.GrpcFsPort
+	case useFtp:
 		if ic.FtpPort == 0 {
 			dlog.Errorf(ctx, "Client is configured to perform remote mounts using FTP, but only SFTP is provided by the traffic-agent")
 			return
@@ -39,7 +69,7 @@ func (ic *intercept) startMount(ctx context.Context, podWG *sync.WaitGroup) {
 			return
 		}
 		port = ic.FtpPort
-	} else {
+	default:
 		if ic.SftpPort == 0 {
 			dlog.Errorf(ctx, "Client is configured to perform remote mounts using SFTP, but only FTP is provided by the traffic-agent")
 			return
@@ -50,9 +80,13 @@ func (ic *intercept) startMount(ctx context.Context, podWG *sync.WaitGroup) {
 	m := ic.Mounter
 	if m == nil {
 		switch {
+		case useSync:
+			m = remotefs.NewSyncMounter(ic.syncOptions, strings.Split(ic.Environment[agentconfig.EnvInterceptMounts], ":"))
 		case ic.localMountPort != 0:
 			session := userd.GetSession(ctx)
 			m = remotefs.NewBridgeMounter(session.SessionInfo().SessionId, session.ManagerClient(), uint16(ic.localMountPort))
+		case useGrpcFs:
+			m = remotefs.NewGRPCMounter(fuseftp)
 		case useFtp:
 			m = remotefs.NewFTPMounter(fuseftp)
 		default:
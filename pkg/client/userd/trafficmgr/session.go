@@ -26,6 +26,8 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/util/homedir"
 
 	"github.com/datawire/dlib/dcontext"
@@ -315,6 +317,51 @@ func EnsureManager(ctx context.Context, req *rpc.HelmRequest, config *client.Kub
 	return helm.EnsureTrafficManager(c, cluster.ConfigFlags, cluster.GetManagerNamespace(), req)
 }
 
+// egressVia splits the given "<name>.<namespace>" into the name and namespace of a workload. The
+// given default namespace is used when the namespace is omitted. Namespaces cannot contain dots, so
+// everything up to the last dot is the name.
+func egressVia(workload, defaultNamespace string) (string, string) {
+	if workload == "" {
+		return "", ""
+	}
+	if i := strings.LastIndexByte(workload, '.'); i > 0 {
+		return workload[:i], workload[i+1:]
+	}
+	return workload, defaultNamespace
+}
+
+// kubeToken returns the bearer token that the client presents to the Kubernetes API server, or an
+// empty string when the client authenticates in some other way, e.g. using a client certificate. The
+// token is obtained from a request to the API server, so that tokens that are provided by exec and
+// auth-provider plugins are found too.
+func kubeToken(ctx context.Context, kc *client.Kubeconfig) string {
+	var token string
+	cfg := rest.CopyConfig(kc.RestConfig)
+	cfg.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(rq *http.Request) (*http.Response, error) {
+			if auth := rq.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+				token = strings.TrimPrefix(auth, "Bearer ")
+			}
+			return rt.RoundTrip(rq)
+		})
+	})
+	ki, err := kubernetes.NewForConfig(cfg)
+	if err == nil {
+		_, err = ki.Discovery().ServerVersion()
+	}
+	if err != nil {
+		dlog.Debugf(ctx, "unable to obtain the kubernetes bearer token: %v", err)
+		return ""
+	}
+	return token
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(rq *http.Request) (*http.Response, error) {
+	return f(rq)
+}
+
 // connectMgr returns a session for the given cluster that is connected to the traffic-manager.
 func connectMgr(
 	ctx context.Context,
@@ -365,6 +412,14 @@ func connectMgr(
 		return nil, err
 	}
 
+	egressWorkload, egressNamespace := egressVia(cr.EgressVia, cluster.Namespace)
+	if si != nil && egressWorkload != "" {
+		// The egress workload is declared when the client arrives, so a cached session cannot be reused.
+		dlog.Debugf(ctx, "discarding cached session %s because outbound connections are routed via %s.%s",
+			si.SessionId, egressWorkload, egressNamespace)
+		si = nil
+	}
+
 	svc := userd.GetService(ctx)
 	if si != nil {
 		// Check if the session is still valid in the traffic-manager by calling Remain
@@ -390,10 +445,13 @@ func connectMgr(
 	if si == nil {
 		dlog.Debugf(ctx, "traffic-manager port-forward established, making client known to the traffic-manager as %q", userAndHost)
 		si, err = mClient.ArriveAsClient(ctx, &manager.ClientInfo{
-			Name:      userAndHost,
-			InstallId: installID,
-			Product:   "telepresence",
-			Version:   client.Version(),
+			Name:            userAndHost,
+			InstallId:       installID,
+			Product:         "telepresence",
+			Version:         client.Version(),
+			KubeToken:       kubeToken(ctx, cluster.Kubeconfig),
+			EgressWorkload:  egressWorkload,
+			EgressNamespace: egressNamespace,
 		})
 		if err != nil {
 			return nil, client.CheckTimeout(ctx, fmt.Errorf("manager.ArriveAsClient: %w", err))
@@ -1007,7 +1065,7 @@ func (s *session) Uninstall(ctx context.Context, ur *rpc.UninstallRequest) (*com
 		for _, an := range ur.Agents {
 			for _, ic := range ics {
 				if ic.Spec.Namespace == namespace && ic.Spec.Agent == an {
-					_ = s.removeIntercept(ctx, ic)
+					_ = s.removeIntercept(ctx, ic, 0)
 					break
 				}
 			}
@@ -9,6 +9,7 @@ import (
 
 	apps "k8s.io/api/apps/v1"
 	core "k8s.io/api/core/v1"
+	errors2 "k8s.io/apimachinery/pkg/api/errors"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -17,6 +18,7 @@ import (
 
 	"github.com/datawire/dlib/dlog"
 	"github.com/datawire/k8sapi/pkg/k8sapi"
+	"github.com/telepresenceio/telepresence/v2/pkg/workload"
 )
 
 type workloadsAndServicesWatcher struct {
@@ -30,12 +32,27 @@ const (
 	deployments  = 0
 	replicasets  = 1
 	statefulsets = 2
+	daemonsets   = 3
 )
 
-// namespacedWASWatcher is watches Workloads And Services (WAS) for a namespace.
+// onDemandTTL is how long the workloads that are listed on demand are cached.
+const onDemandTTL = 10 * time.Second
+
+// onDemandWorkloads are the workloads that match a label selector, listed on demand.
+type onDemandWorkloads struct {
+	wls     []k8sapi.Workload
+	expires time.Time
+}
+
+// namespacedWASWatcher is watches Workloads And Services (WAS) for a namespace. Bare pods and Argo Rollouts
+// are not watched. Bare pods are rare, and watching them means watching all pods in the namespace. The Argo
+// Rollout CRD might not be installed. They are listed on demand instead, and cached for onDemandTTL.
 type namespacedWASWatcher struct {
 	svcWatcher *k8sapi.Watcher[*core.Service]
-	wlWatchers [3]*k8sapi.Watcher[runtime.Object]
+	wlWatchers [4]*k8sapi.Watcher[runtime.Object]
+
+	onDemandLock sync.Mutex
+	onDemand     map[string]*onDemandWorkloads // keyed by label selector
 }
 
 // svcEquals compare only the Service fields that are of interest to Telepresence. They are
@@ -67,7 +84,7 @@ nextMP:
 	return true
 }
 
-// workloadEquals compare only the workload (Deployment, ResourceSet, or StatefulSet) fields that are of interest to Telepresence. They are
+// workloadEquals compare only the workload (Deployment, ResourceSet, StatefulSet, or DaemonSet) fields that are of interest to Telepresence. They are
 //
 //   - UID
 //   - Name
@@ -76,12 +93,12 @@ nextMP:
 //   - Labels
 //   - Containers (must contain an equal number of equally named containers with equal ports)
 func workloadEquals(oa, ob runtime.Object) bool {
-	a, err := k8sapi.WrapWorkload(oa)
+	a, err := workload.WrapWorkload(oa)
 	if err != nil {
 		// This should definitely never happen
 		panic(err)
 	}
-	b, err := k8sapi.WrapWorkload(ob)
+	b, err := workload.WrapWorkload(ob)
 	if err != nil {
 		// This should definitely never happen
 		panic(err)
@@ -144,11 +161,13 @@ func newNamespaceWatcher(c context.Context, namespace string, cond *sync.Cond) *
 	appsGetter := ki.AppsV1().RESTClient()
 	w := &namespacedWASWatcher{
 		svcWatcher: k8sapi.NewWatcher("services", ki.CoreV1().RESTClient(), cond, k8sapi.WithEquals(svcEquals), k8sapi.WithNamespace[*core.Service](namespace)),
-		wlWatchers: [3]*k8sapi.Watcher[runtime.Object]{
+		wlWatchers: [4]*k8sapi.Watcher[runtime.Object]{
 			k8sapi.NewWatcher("deployments", appsGetter, cond, k8sapi.WithEquals(workloadEquals), k8sapi.WithNamespace[runtime.Object](namespace)),
 			k8sapi.NewWatcher("replicasets", appsGetter, cond, k8sapi.WithEquals(workloadEquals), k8sapi.WithNamespace[runtime.Object](namespace)),
 			k8sapi.NewWatcher("statefulsets", appsGetter, cond, k8sapi.WithEquals(workloadEquals), k8sapi.WithNamespace[runtime.Object](namespace)),
+			k8sapi.NewWatcher("daemonsets", appsGetter, cond, k8sapi.WithEquals(workloadEquals), k8sapi.WithNamespace[runtime.Object](namespace)),
 		},
+		onDemand: make(map[string]*onDemandWorkloads),
 	}
 	return w
 }
@@ -161,10 +180,15 @@ func (nw *namespacedWASWatcher) cancel() {
 }
 
 func (nw *namespacedWASWatcher) hasSynced() bool {
-	return nw.svcWatcher.HasSynced() &&
-		nw.wlWatchers[0].HasSynced() &&
-		nw.wlWatchers[1].HasSynced() &&
-		nw.wlWatchers[2].HasSynced()
+	if !nw.svcWatcher.HasSynced() {
+		return false
+	}
+	for _, w := range nw.wlWatchers {
+		if !w.HasSynced() {
+			return false
+		}
+	}
+	return true
 }
 
 func newWASWatcher() *workloadsAndServicesWatcher {
@@ -355,6 +379,11 @@ func (nw *namespacedWASWatcher) findMatchingWorkloads(c context.Context, svc *co
 	for i, wlw := range nw.wlWatchers {
 		wls, err := wlw.List(c)
 		if err != nil {
+			if errors2.IsForbidden(err) || errors2.IsNotFound(err) {
+				// The user isn't permitted to list this kind of workload, so it will never match.
+				dlog.Debugf(c, "unable to list workloads in namespace %s: %v", svc.Namespace, err)
+				continue
+			}
 			return nil, err
 		}
 		for _, o := range wls {
@@ -366,6 +395,8 @@ func (nw *namespacedWASWatcher) findMatchingWorkloads(c context.Context, svc *co
 				wl = k8sapi.ReplicaSet(o.(*apps.ReplicaSet))
 			case statefulsets:
 				wl = k8sapi.StatefulSet(o.(*apps.StatefulSet))
+			case daemonsets:
+				wl = workload.DaemonSet(o.(*apps.DaemonSet))
 			}
 			if selector.Matches(labels.Set(wl.GetLabels())) {
 				owl, err := nw.maybeReplaceWithOwner(c, wl)
@@ -377,6 +408,12 @@ func (nw *namespacedWASWatcher) findMatchingWorkloads(c context.Context, svc *co
 		}
 	}
 
+	odWls, err := nw.onDemandWorkloads(c, svc.Namespace, svc.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	allWls = append(allWls, odWls...)
+
 	// Prefer entries with matching ports. I.e. strip all non-matching if matching entries
 	// are found.
 	if pfWls := filterByNamedTargetPort(c, targetPortNames, allWls); len(pfWls) > 0 {
@@ -385,10 +422,38 @@ func (nw *namespacedWASWatcher) findMatchingWorkloads(c context.Context, svc *co
 	return allWls, nil
 }
 
+// onDemandWorkloads returns the bare pods and Argo Rollouts in the given namespace that match the given
+// label selector. The result is cached for onDemandTTL.
+func (nw *namespacedWASWatcher) onDemandWorkloads(c context.Context, namespace string, selector labels.Set) ([]k8sapi.Workload, error) {
+	key := selector.String()
+	now := time.Now()
+	nw.onDemandLock.Lock()
+	defer nw.onDemandLock.Unlock()
+	if od, ok := nw.onDemand[key]; ok && now.Before(od.expires) {
+		return od.wls, nil
+	}
+	wls, err := workload.BarePods(c, namespace, selector)
+	if err != nil {
+		return nil, err
+	}
+	rls, err := workload.Rollouts(c, namespace, selector)
+	if err != nil {
+		return nil, err
+	}
+	wls = append(wls, rls...)
+	for k, od := range nw.onDemand {
+		if !now.Before(od.expires) {
+			delete(nw.onDemand, k)
+		}
+	}
+	nw.onDemand[key] = &onDemandWorkloads{wls: wls, expires: now.Add(onDemandTTL)}
+	return wls, nil
+}
+
 func (nw *namespacedWASWatcher) maybeReplaceWithOwner(c context.Context, wl k8sapi.Workload) (k8sapi.Workload, error) {
 	var err error
 	for _, or := range wl.GetOwnerReferences() {
-		if or.Controller != nil && *or.Controller && or.Kind == "Deployment" {
+		if or.Controller != nil && *or.Controller && (or.Kind == workload.DeploymentKind || or.Kind == workload.RolloutKind) {
 			// Chances are that the owner's labels doesn't match, but we really want the owner anyway.
 			wl, err = nw.replaceWithOwner(c, wl, or.Kind, or.Name)
 			break
@@ -398,6 +463,15 @@ func (nw *namespacedWASWatcher) maybeReplaceWithOwner(c context.Context, wl k8sa
 }
 
 func (nw *namespacedWASWatcher) replaceWithOwner(c context.Context, wl k8sapi.Workload, kind, name string) (k8sapi.Workload, error) {
+	if kind == workload.RolloutKind {
+		od, err := workload.GetRollout(c, name, wl.GetNamespace())
+		if err != nil {
+			return nil, fmt.Errorf("get %s owner %s for %s %s.%s: %v",
+				kind, name, wl.GetKind(), wl.GetName(), wl.GetNamespace(), err)
+		}
+		dlog.Debugf(c, "replacing %s %s.%s, with owner %s %s", wl.GetKind(), wl.GetName(), wl.GetNamespace(), kind, name)
+		return od, nil
+	}
 	od, found, err := nw.wlWatchers[deployments].Get(c, &apps.Deployment{
 		ObjectMeta: meta.ObjectMeta{
 			Name:      name,
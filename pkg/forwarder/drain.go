@@ -0,0 +1,149 @@
+package forwarder
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+)
+
+// interceptTunnels are the connections and requests that are currently tunneled to the client
+// of an intercept.
+type interceptTunnels struct {
+	cancels map[uint64]context.CancelFunc
+
+	// drained is created when the intercept starts draining, and closed when its last tunnel ends.
+	drained chan struct{}
+}
+
+// isDraining returns true if the given intercept is being removed. New connections and requests
+// must then be sent to the intercepted container.
+func isDraining(ii *manager.InterceptInfo) bool {
+	return ii.Disposition == manager.InterceptDispositionType_DRAINING
+}
+
+// trackTunnel registers a connection or request that is tunneled to the client of the given intercept.
+// It returns a context that is cancelled when the intercept's drain timeout passes, and a function that
+// must be called when the tunnel ends.
+func (f *interceptor) trackTunnel(ctx context.Context, ii *manager.InterceptInfo) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	f.mu.Lock()
+	if f.tunnels == nil {
+		f.tunnels = make(map[string]*interceptTunnels)
+	}
+	it, ok := f.tunnels[ii.Id]
+	if !ok {
+		it = &interceptTunnels{cancels: make(map[uint64]context.CancelFunc)}
+		f.tunnels[ii.Id] = it
+	}
+	f.tunnelSeq++
+	seq := f.tunnelSeq
+	it.cancels[seq] = cancel
+	f.mu.Unlock()
+
+	return ctx, func() {
+		cancel()
+		f.mu.Lock()
+		delete(it.cancels, seq)
+		if len(it.cancels) == 0 {
+			if it.drained != nil {
+				close(it.drained)
+			}
+			if f.tunnels[ii.Id] == it {
+				delete(f.tunnels, ii.Id)
+			}
+		}
+		f.mu.Unlock()
+	}
+}
+
+// startDrainLocked lets the connections and requests that are tunneled to the client of the given
+// intercept complete. They are cancelled when the intercept's drain timeout passes. The traffic-manager
+// is told when no tunnels remain. The intercept remains DRAINING in the snapshots that follow, so the
+// drain is only started once, using the given mechanism. False is returned when it was started already.
+// Must be called with f.mu locked.
+func (f *interceptor) startDrainLocked(ii *manager.InterceptInfo, mechanism string) bool {
+	if _, ok := f.drains[ii.Id]; ok {
+		return false
+	}
+	if f.drains == nil {
+		f.drains = make(map[string]string)
+	}
+	f.drains[ii.Id] = mechanism
+	it, ok := f.tunnels[ii.Id]
+	if !ok {
+		it = &interceptTunnels{}
+	}
+	it.drained = make(chan struct{})
+	if len(it.cancels) == 0 {
+		close(it.drained)
+		delete(f.tunnels, ii.Id)
+	}
+	ctx := f.lCtx
+	dlog.Debugf(ctx, "Draining %d connections of intercept %q", len(it.cancels), ii.Spec.Name)
+	go func() {
+		timer := time.NewTimer(ii.DrainTimeout.AsDuration())
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return
+		case <-it.drained:
+		case <-timer.C:
+			f.mu.Lock()
+			dlog.Infof(ctx, "Closing %d connections of intercept %q because the drain timeout passed", len(it.cancels), ii.Spec.Name)
+			for _, cancel := range it.cancels {
+				cancel()
+			}
+			f.mu.Unlock()
+		}
+		if f.drainEnded != nil {
+			f.drainEnded(ii.Id)
+		}
+		f.reportDrained(ctx, ii)
+	}()
+	return true
+}
+
+// pruneDrainsLocked forgets the drains of the intercepts that use the given mechanism and that aren't
+// draining among the given intercepts, because the traffic-manager has removed them, or because a new
+// intercept now uses the same ID. Must be called with f.mu locked.
+func (f *interceptor) pruneDrainsLocked(mechanism string, iis ...*manager.InterceptInfo) {
+nextDrain:
+	for id, m := range f.drains {
+		if m != mechanism {
+			continue
+		}
+		for _, ii := range iis {
+			if ii != nil && ii.Id == id && isDraining(ii) {
+				continue nextDrain
+			}
+		}
+		delete(f.drains, id)
+	}
+}
+
+// reportDrained tells the traffic-manager that no connections are tunneled to the client of the given
+// intercept, so that it can be removed.
+func (f *interceptor) reportDrained(ctx context.Context, ii *manager.InterceptInfo) {
+	f.mu.Lock()
+	mc := f.manager
+	sessionInfo := f.sessionInfo
+	f.mu.Unlock()
+	if mc == nil {
+		return
+	}
+	_, err := mc.ReviewIntercept(ctx, &manager.ReviewInterceptRequest{
+		Session:     sessionInfo,
+		Id:          ii.Id,
+		Disposition: manager.InterceptDispositionType_DRAINING,
+		Drained:     true,
+	})
+	// The intercept is gone if it has been reported already.
+	if err != nil && status.Code(err) != codes.NotFound && ctx.Err() == nil {
+		dlog.Errorf(ctx, "failed to report that intercept %q is drained: %v", ii.Spec.Name, err)
+	}
+}
@@ -0,0 +1,74 @@
+package forwarder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+)
+
+// reviewRecorder is a ManagerClient that records the intercepts that are reported as drained.
+type reviewRecorder struct {
+	manager.ManagerClient
+	drained chan string
+}
+
+func (r *reviewRecorder) ReviewIntercept(_ context.Context, rq *manager.ReviewInterceptRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	if rq.Drained {
+		r.drained <- rq.Id
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func TestDrainReportedOnce(t *testing.T) {
+	ctx, cancel := context.WithCancel(dlog.NewTestContext(t, false))
+	defer cancel()
+
+	rr := &reviewRecorder{drained: make(chan string, 10)}
+	f := &interceptor{lCtx: ctx}
+	f.SetManager(&manager.SessionInfo{SessionId: "agent"}, rr, semver.MustParse("2.13.0"))
+
+	ii := &manager.InterceptInfo{
+		Id:           "client:echo",
+		Disposition:  manager.InterceptDispositionType_DRAINING,
+		DrainTimeout: durationpb.New(time.Minute),
+		Spec:         &manager.InterceptSpec{Name: "echo"},
+	}
+	expectReports := func(n int) {
+		t.Helper()
+		for i := 0; i < n; i++ {
+			select {
+			case id := <-rr.drained:
+				assert.Equal(t, ii.Id, id)
+			case <-time.After(5 * time.Second):
+				require.FailNow(t, "intercept was not reported as drained")
+			}
+		}
+		select {
+		case <-rr.drained:
+			assert.Fail(t, "intercept was reported as drained more than once")
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	// The intercept remains DRAINING in every snapshot until the traffic-manager removes it.
+	for i := 0; i < 3; i++ {
+		f.SetIntercepting(ii)
+	}
+	expectReports(1)
+
+	// A new intercept with the same ID is drained and reported again once the old one has been removed.
+	f.SetIntercepting(nil)
+	f.SetIntercepting(ii)
+	f.SetIntercepting(ii)
+	expectReports(1)
+}
@@ -0,0 +1,248 @@
+package forwarder
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/pkg/ipproto"
+	"github.com/telepresenceio/telepresence/v2/pkg/iputil"
+	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
+)
+
+const (
+	// healthCheckInterval is the interval between the health checks of an intercept target.
+	healthCheckInterval = 5 * time.Second
+
+	// healthCheckTimeout is the time that a health check may take.
+	healthCheckTimeout = 5 * time.Second
+
+	// defaultDialResultTimeout is the time to wait for the client to dial the intercept target
+	// when the intercept doesn't specify a dial timeout.
+	defaultDialResultTimeout = 5 * time.Second
+)
+
+// errTargetUnreachable is returned when the client fails to dial the target of an intercept that
+// falls back to the intercepted container.
+var errTargetUnreachable = errors.New("the intercept target on the client is unreachable")
+
+// usesFallback returns true if connections for the given intercept are sent to the intercepted
+// container while the target on the client is unreachable.
+func usesFallback(ii *manager.InterceptInfo) bool {
+	return !ii.Spec.Mirror && (ii.Spec.Fallback || ii.Spec.HealthCheckPath != "")
+}
+
+// targetHealth is the health of the target of an intercept on the client.
+type targetHealth struct {
+	// reportMu ensures that changes are reported to the traffic-manager in the order they occur.
+	reportMu sync.Mutex
+	down     bool
+	kind     string
+	cancel   context.CancelFunc
+}
+
+// fallbackActive returns true if connections for the given intercept must be sent to the
+// intercepted container, because the target on the client is unreachable.
+func (f *interceptor) fallbackActive(ii *manager.InterceptInfo) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	th, ok := f.health[ii.Id]
+	return ok && th.down
+}
+
+// setHealthChecksLocked starts health checks for the intercepts of the given kind that use fallback,
+// and stops the checks of intercepts of that kind that are no longer present. Fallback is only
+// supported for TCP. Must be called with f.mu locked.
+func (f *interceptor) setHealthChecksLocked(kind string, iis ...*manager.InterceptInfo) {
+	if _, isTCP := f.listenAddr.(*net.TCPAddr); !isTCP {
+		return
+	}
+	keep := make(map[string]struct{}, len(iis))
+	for _, ii := range iis {
+		if ii == nil || !usesFallback(ii) {
+			continue
+		}
+		keep[ii.Id] = struct{}{}
+		if _, ok := f.health[ii.Id]; ok {
+			continue
+		}
+		if f.health == nil {
+			f.health = make(map[string]*targetHealth)
+		}
+		ctx, cancel := context.WithCancel(f.lCtx)
+		f.health[ii.Id] = &targetHealth{kind: kind, cancel: cancel}
+		go f.checkHealth(ctx, ii)
+	}
+	for id, th := range f.health {
+		if _, ok := keep[id]; !ok && th.kind == kind {
+			th.cancel()
+			delete(f.health, id)
+		}
+	}
+}
+
+// setTargetHealth records whether the target of the given intercept is reachable, and reports
+// a change to the traffic-manager.
+func (f *interceptor) setTargetHealth(ctx context.Context, ii *manager.InterceptInfo, up bool, reason string) {
+	f.mu.Lock()
+	th, ok := f.health[ii.Id]
+	f.mu.Unlock()
+	if !ok {
+		return
+	}
+	th.reportMu.Lock()
+	defer th.reportMu.Unlock()
+
+	f.mu.Lock()
+	if th.down != up {
+		// No change
+		f.mu.Unlock()
+		return
+	}
+	th.down = !up
+	mc := f.manager
+	sessionInfo := f.sessionInfo
+	f.mu.Unlock()
+
+	rq := &manager.ReviewInterceptRequest{
+		Session:        sessionInfo,
+		Id:             ii.Id,
+		Disposition:    manager.InterceptDispositionType_ACTIVE,
+		FallbackActive: !up,
+		HealthReport:   true,
+	}
+	if up {
+		dlog.Infof(ctx, "Target of intercept %q is reachable. Traffic is sent to the client", ii.Spec.Name)
+	} else {
+		dlog.Infof(ctx, "Target of intercept %q is unreachable: %s. Traffic is sent to the intercepted container", ii.Spec.Name, reason)
+		rq.Message = reason
+	}
+	if mc == nil {
+		return
+	}
+	if _, err := mc.ReviewIntercept(ctx, rq); err != nil && ctx.Err() == nil {
+		dlog.Errorf(ctx, "failed to report the health of intercept %q: %v", ii.Spec.Name, err)
+	}
+}
+
+// checkHealth checks the target of the given intercept until the context is cancelled. Intercepts
+// without a health check path are only checked while they fall back, because the dials of
+// intercepted connections tell whether the target is reachable.
+func (f *interceptor) checkHealth(ctx context.Context, ii *manager.InterceptInfo) {
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if ii.Spec.HealthCheckPath == "" && !f.fallbackActive(ii) {
+			continue
+		}
+		err := f.probeTarget(ctx, ii)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			f.setTargetHealth(ctx, ii, false, err.Error())
+		} else {
+			f.setTargetHealth(ctx, ii, true, "")
+		}
+	}
+}
+
+// probeTarget checks that the client can dial the target of the given intercept and, when the
+// intercept has a health check path, that the target responds to a GET of that path with a
+// status below 400.
+func (f *interceptor) probeTarget(ctx context.Context, ii *manager.InterceptInfo) error {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	// The probe is tagged, so that it isn't mistaken for intercepted traffic.
+	spec := ii.Spec
+	id := tunnel.NewProbeConnID(ipproto.TCP, iputil.Parse(spec.TargetHost), f.nextSrcPort(), uint16(spec.TargetPort))
+	s, err := f.openTunnel(ctx, id, ii)
+	if err != nil || spec.HealthCheckPath == "" {
+		return err
+	}
+
+	local, remote := net.Pipe()
+	defer local.Close()
+	d := tunnel.NewConnEndpoint(s, remote, cancel)
+	d.Start(ctx)
+
+	url := "http://" + net.JoinHostPort(spec.TargetHost, strconv.Itoa(int(spec.TargetPort))) + spec.HealthCheckPath
+	rq, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	rq.Close = true
+	if dl, ok := ctx.Deadline(); ok {
+		_ = local.SetDeadline(dl)
+	}
+	go func() {
+		_ = rq.Write(local)
+	}()
+	rs, err := http.ReadResponse(bufio.NewReader(local), rq)
+	if err != nil {
+		return fmt.Errorf("health check of %s failed: %w", spec.HealthCheckPath, err)
+	}
+	_ = rs.Body.Close()
+	if rs.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("health check of %s returned %s", spec.HealthCheckPath, rs.Status)
+	}
+	return nil
+}
+
+// awaitDial waits for the client to report the result of dialing the target of the given intercept.
+func (f *interceptor) awaitDial(ctx context.Context, s tunnel.Stream, ii *manager.InterceptInfo) error {
+	spec := ii.Spec
+	timeout := time.Duration(spec.DialTimeout) + time.Duration(spec.RoundtripLatency)
+	if timeout <= 0 {
+		timeout = defaultDialResultTimeout
+	}
+	type result struct {
+		msg tunnel.Message
+		err error
+	}
+	rc := make(chan result, 1)
+	go func() {
+		m, err := s.Receive(ctx)
+		rc <- result{msg: m, err: err}
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	var reason string
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		reason = "timeout waiting for the client to connect"
+	case r := <-rc:
+		switch {
+		case r.err != nil:
+			reason = fmt.Sprintf("tunnel failed: %v", r.err)
+		case r.msg.Code() == tunnel.DialOK:
+			if spec.HealthCheckPath == "" {
+				f.setTargetHealth(ctx, ii, true, "")
+			}
+			return nil
+		case r.msg.Code() == tunnel.DialReject:
+			reason = fmt.Sprintf("the client was unable to connect to %s:%d", spec.TargetHost, spec.TargetPort)
+		default:
+			return fmt.Errorf("unexpected tunnel message %s while waiting for dial result. Id %s", r.msg, s.ID())
+		}
+	}
+	f.setTargetHealth(ctx, ii, false, reason)
+	return errTargetUnreachable
+}
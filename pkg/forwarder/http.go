@@ -0,0 +1,440 @@
+package forwarder
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"sync"
+	"time"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/pkg/ipproto"
+	"github.com/telepresenceio/telepresence/v2/pkg/iputil"
+	"github.com/telepresenceio/telepresence/v2/pkg/matcher"
+	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
+)
+
+// HTTPInterceptor is implemented by interceptors that are capable of routing individual
+// HTTP requests to different intercepts.
+type HTTPInterceptor interface {
+	Interceptor
+	SetHTTPIntercepting([]*HTTPIntercept)
+}
+
+// HTTPIntercept is an intercept that uses the "http" mechanism. Only requests that are matched by
+// its Request matcher are routed to the intercepting client. All other requests are sent to the
+// application container.
+type HTTPIntercept struct {
+	*manager.InterceptInfo
+	Request matcher.Request
+}
+
+// httpProxy is a layer 7 proxy that serves HTTP/1.1 and cleartext HTTP/2 (h2c) connections that are
+// accepted by a tcp forwarder while it has HTTP intercepts. Each request is routed to the first intercept
+// whose matcher matches the request, or to the forwarder's target when no intercept matches.
+type httpProxy struct {
+	ctx     context.Context
+	fwd     *tcp
+	conns   chan net.Conn
+	server  *http.Server
+	app     *httputil.ReverseProxy
+	appTLS  *httputil.ReverseProxy
+	proxyMu sync.Mutex
+	proxies map[string]*httputil.ReverseProxy
+
+	// tunnels are the connections that are tunneled to the clients of the intercepts, keyed by intercept
+	// ID. The reverse proxies keep them in their pools between requests. Guarded by proxyMu.
+	tunnels map[string]map[*tunnelConn]struct{}
+}
+
+// tunnelConn is a connection that is tunneled to the client of an intercept.
+type tunnelConn struct {
+	net.Conn
+	hp          *httpProxy
+	interceptID string
+	once        sync.Once
+}
+
+func (c *tunnelConn) Close() error {
+	c.once.Do(func() {
+		c.hp.proxyMu.Lock()
+		delete(c.hp.tunnels[c.interceptID], c)
+		if len(c.hp.tunnels[c.interceptID]) == 0 {
+			delete(c.hp.tunnels, c.interceptID)
+		}
+		c.hp.proxyMu.Unlock()
+	})
+	return c.Conn.Close()
+}
+
+// chanListener is a net.Listener that produces the connections that are sent to its channel.
+type chanListener struct {
+	ctx   context.Context
+	addr  net.Addr
+	conns <-chan net.Conn
+}
+
+func (l *chanListener) Accept() (net.Conn, error) {
+	select {
+	case <-l.ctx.Done():
+		return nil, net.ErrClosed
+	case conn := <-l.conns:
+		return conn, nil
+	}
+}
+
+func (l *chanListener) Close() error {
+	return nil
+}
+
+func (l *chanListener) Addr() net.Addr {
+	return l.addr
+}
+
+// terminatedConn is a connection whose TLS was terminated by the forwarder. Requests read from it are
+// sent to the application using TLS.
+type terminatedConn struct {
+	net.Conn
+}
+
+type terminatedKey struct{}
+
+// roundTripper sends HTTP/2 requests using an HTTP/2 cleartext transport and all other requests
+// using an HTTP/1.1 transport. Both transports use the same dial function. TLS is originated over the
+// dialed connections when the originating config is non-nil.
+type roundTripper struct {
+	h1 *http.Transport
+	h2 *http2.Transport
+}
+
+func newRoundTripper(dial func(context.Context, string, string) (net.Conn, error), originating *tls.Config) *roundTripper {
+	h1Dial, h2Dial := dial, dial
+	if originating != nil {
+		originatingDial := func(proto string) func(context.Context, string, string) (net.Conn, error) {
+			return func(ctx context.Context, network, addr string) (net.Conn, error) {
+				conn, err := dial(ctx, network, addr)
+				if err != nil {
+					return nil, err
+				}
+				host, _, _ := net.SplitHostPort(addr)
+				return originateTLS(ctx, conn, originatingConfig(originating, host, proto))
+			}
+		}
+		h1Dial, h2Dial = originatingDial("http/1.1"), originatingDial("h2")
+	}
+	return &roundTripper{
+		h1: &http.Transport{
+			DialContext:         h1Dial,
+			MaxIdleConnsPerHost: 10,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		h2: &http2.Transport{
+			AllowHTTP: true,
+			DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+				return h2Dial(ctx, network, addr)
+			},
+		},
+	}
+}
+
+func (t *roundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.ProtoMajor == 2 {
+		return t.h2.RoundTrip(r)
+	}
+	return t.h1.RoundTrip(r)
+}
+
+func (t *roundTripper) CloseIdleConnections() {
+	t.h1.CloseIdleConnections()
+	t.h2.CloseIdleConnections()
+}
+
+// newHTTPProxy creates a proxy for the given forwarder. It must be called with the forwarder's mutex locked.
+func newHTTPProxy(ctx context.Context, fwd *tcp) *httpProxy {
+	hp := &httpProxy{
+		ctx:     ctx,
+		fwd:     fwd,
+		conns:   make(chan net.Conn),
+		proxies: make(map[string]*httputil.ReverseProxy),
+		tunnels: make(map[string]map[*tunnelConn]struct{}),
+	}
+	dialApp := func(ctx context.Context, network, _ string) (net.Conn, error) {
+		targetHost, targetPort := fwd.Target()
+		d := net.Dialer{}
+		return d.DialContext(ctx, network, fmt.Sprintf("%s:%d", targetHost, targetPort))
+	}
+	hp.app = hp.newReverseProxy(dialApp, nil)
+	if fwd.terminating != nil {
+		originating := fwd.originating
+		if originating == nil {
+			originating = &tls.Config{}
+		}
+		hp.appTLS = hp.newReverseProxy(dialApp, originating)
+	}
+	hp.server = &http.Server{
+		// Terminated connections are served as cleartext. HTTP/2 negotiated by their TLS handshake is
+		// served by the h2c handler, because the server never sees the *tls.Conn.
+		Handler:     h2c.NewHandler(hp, &http2.Server{}),
+		BaseContext: func(net.Listener) context.Context { return ctx },
+		ConnContext: func(ctx context.Context, conn net.Conn) context.Context {
+			if _, ok := conn.(*terminatedConn); ok {
+				ctx = context.WithValue(ctx, terminatedKey{}, true)
+			}
+			return ctx
+		},
+		ErrorLog: dlog.StdLogger(ctx, dlog.LogLevelDebug),
+	}
+	go func() {
+		if err := hp.server.Serve(&chanListener{ctx: ctx, addr: fwd.listenAddr, conns: hp.conns}); err != nil && ctx.Err() == nil {
+			dlog.Errorf(ctx, "HTTP proxy failed: %v", err)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		_ = hp.server.Close()
+		hp.proxyMu.Lock()
+		for _, p := range hp.proxies {
+			p.Transport.(*roundTripper).CloseIdleConnections()
+		}
+		hp.proxyMu.Unlock()
+		hp.app.Transport.(*roundTripper).CloseIdleConnections()
+		if hp.appTLS != nil {
+			hp.appTLS.Transport.(*roundTripper).CloseIdleConnections()
+		}
+	}()
+	return hp
+}
+
+func (hp *httpProxy) newReverseProxy(dial func(context.Context, string, string) (net.Conn, error), originating *tls.Config) *httputil.ReverseProxy {
+	return &httputil.ReverseProxy{
+		Director: func(r *http.Request) {
+			r.URL.Scheme = "http"
+			r.URL.Host = r.Host
+		},
+		Transport:     newRoundTripper(dial, originating),
+		FlushInterval: -1,
+		ErrorLog:      dlog.StdLogger(hp.ctx, dlog.LogLevelDebug),
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			dlog.Errorf(r.Context(), "proxying %s %s failed: %v", r.Method, r.URL.Path, err)
+			w.WriteHeader(http.StatusBadGateway)
+		},
+	}
+}
+
+// serveConn hands the given connection over to the proxy's HTTP server. The call returns
+// immediately. The server will close the connection when it's done with it. The terminated
+// flag tells if the forwarder terminated the TLS of the connection.
+func (hp *httpProxy) serveConn(conn net.Conn, terminated bool) {
+	if terminated {
+		conn = &terminatedConn{Conn: conn}
+	}
+	select {
+	case <-hp.ctx.Done():
+		_ = conn.Close()
+	case hp.conns <- conn:
+	}
+}
+
+// setIntercepts drops the reverse proxies of intercepts that are no longer present.
+func (hp *httpProxy) setIntercepts(ics []*HTTPIntercept) {
+	hp.proxyMu.Lock()
+	defer hp.proxyMu.Unlock()
+	for id, p := range hp.proxies {
+		found := false
+		for _, ic := range ics {
+			if ic.Id == id {
+				found = true
+				break
+			}
+		}
+		if !found {
+			p.Transport.(*roundTripper).CloseIdleConnections()
+			delete(hp.proxies, id)
+		}
+	}
+}
+
+func (hp *httpProxy) interceptProxy(ic *HTTPIntercept) *httputil.ReverseProxy {
+	hp.proxyMu.Lock()
+	defer hp.proxyMu.Unlock()
+	p, ok := hp.proxies[ic.Id]
+	if !ok {
+		ii := ic.InterceptInfo
+		p = hp.newReverseProxy(func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return hp.dialIntercept(ctx, ii)
+		}, nil)
+		if usesFallback(ii) {
+			eh := p.ErrorHandler
+			p.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
+				// The request can be sent to the application instead, provided that it has no body
+				// that the failed attempt might have consumed.
+				if errors.Is(err, errTargetUnreachable) && r.Body == http.NoBody {
+					dlog.Debugf(r.Context(), "%s %s falls back to application", r.Method, r.URL.Path)
+					hp.appProxy(r).ServeHTTP(w, r)
+					return
+				}
+				eh(w, r, err)
+			}
+		}
+		hp.proxies[ic.Id] = p
+	}
+	return p
+}
+
+// dialIntercept creates a connection that is tunneled to the client that owns the given intercept.
+func (hp *httpProxy) dialIntercept(ctx context.Context, ii *manager.InterceptInfo) (net.Conn, error) {
+	srcIP, err := hp.sourceIP(ctx)
+	if err != nil {
+		return nil, err
+	}
+	spec := ii.Spec
+	id := tunnel.NewConnID(ipproto.TCP, srcIP, iputil.Parse(spec.TargetHost), hp.fwd.nextSrcPort(), uint16(spec.TargetPort))
+
+	// The tunnel must outlive the context of the dial, so it uses the lifetime of the proxy.
+	tCtx, cancel := context.WithCancel(hp.ctx)
+	s, err := hp.fwd.openTunnel(tCtx, id, ii)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	local, remote := net.Pipe()
+	tunnel.NewConnEndpoint(s, remote, cancel).Start(tCtx)
+	tc := &tunnelConn{Conn: local, hp: hp, interceptID: ii.Id}
+	hp.proxyMu.Lock()
+	tcs, ok := hp.tunnels[ii.Id]
+	if !ok {
+		tcs = make(map[*tunnelConn]struct{})
+		hp.tunnels[ii.Id] = tcs
+	}
+	tcs[tc] = struct{}{}
+	hp.proxyMu.Unlock()
+	return tc, nil
+}
+
+// sourceIP returns the source IP of a connection that is dialed for a request with the given context. It's the
+// local IP of the connection that the request arrived on, which is the IP of the pod, because the forwarder
+// normally listens on all interfaces. The listen address is used when the request has no local address.
+func (hp *httpProxy) sourceIP(ctx context.Context) (net.IP, error) {
+	addrs := []net.Addr{hp.fwd.listenAddr}
+	if la, ok := ctx.Value(http.LocalAddrContextKey).(net.Addr); ok {
+		addrs = append([]net.Addr{la}, addrs...)
+	}
+	for _, addr := range addrs {
+		// An unspecified IP can't be used, because it tags the connections of health probes.
+		if ip, _, err := iputil.SplitToIPPort(addr); err == nil && !ip.IsUnspecified() {
+			return ip, nil
+		}
+	}
+	return nil, fmt.Errorf("unable to determine the source IP of connections from %s", hp.fwd.listenAddr)
+}
+
+// drainStarted closes the idle connections that are pooled for requests to the client of the
+// given intercept. No new requests are routed to a draining intercept.
+func (hp *httpProxy) drainStarted(interceptID string) {
+	hp.proxyMu.Lock()
+	p, ok := hp.proxies[interceptID]
+	hp.proxyMu.Unlock()
+	if ok {
+		p.Transport.(*roundTripper).CloseIdleConnections()
+	}
+}
+
+// drainEnded closes the remaining connections that are tunneled to the client of the given intercept.
+// They are idle unless the drain timed out.
+func (hp *httpProxy) drainEnded(interceptID string) {
+	hp.proxyMu.Lock()
+	tcs := hp.tunnels[interceptID]
+	delete(hp.tunnels, interceptID)
+	hp.proxyMu.Unlock()
+	for tc := range tcs {
+		_ = tc.Close()
+	}
+}
+
+func (hp *httpProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ic := hp.fwd.matchingHTTPIntercept(r)
+	if ic == nil || !sampled(ic.InterceptInfo) || hp.fwd.fallbackActive(ic.InterceptInfo) {
+		dlog.Tracef(r.Context(), "%s %s routed to application", r.Method, r.URL.Path)
+		hp.appProxy(r).ServeHTTP(w, r)
+		return
+	}
+	dlog.Tracef(r.Context(), "%s %s routed to intercept %s", r.Method, r.URL.Path, ic.Spec.Name)
+	ctx, done := hp.fwd.trackTunnel(r.Context(), ic.InterceptInfo)
+	defer done()
+	hp.interceptProxy(ic).ServeHTTP(w, r.WithContext(ctx))
+}
+
+// appProxy returns the proxy that sends the given request to the application. Requests that were read
+// from terminated connections are sent using TLS.
+func (hp *httpProxy) appProxy(r *http.Request) *httputil.ReverseProxy {
+	if hp.appTLS != nil && r.Context().Value(terminatedKey{}) == true {
+		return hp.appTLS
+	}
+	return hp.app
+}
+
+// SetHTTPIntercepting sets the HTTP intercepts that are served by this forwarder. Connections are no longer
+// forwarded as a whole when a forwarder has HTTP intercepts. Instead, each request is routed based on the
+// HTTP intercept matchers.
+func (f *tcp) SetHTTPIntercepting(ics []*HTTPIntercept) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	all := make([]*manager.InterceptInfo, len(ics))
+	iis := make([]*manager.InterceptInfo, 0, len(ics))
+	for i, ic := range ics {
+		all[i] = ic.InterceptInfo
+		if isDraining(ic.InterceptInfo) {
+			if f.startDrainLocked(ic.InterceptInfo, "http") && f.httpProxy != nil {
+				f.httpProxy.drainStarted(ic.Id)
+			}
+		} else {
+			iis = append(iis, ic.InterceptInfo)
+		}
+	}
+	f.pruneDrainsLocked("http", all...)
+	f.setHealthChecksLocked("http", iis...)
+	hadIntercepts := len(f.httpIntercepts) > 0
+	f.httpIntercepts = ics
+	hasIntercepts := len(ics) > 0
+	if hadIntercepts == hasIntercepts {
+		if f.httpProxy != nil {
+			f.httpProxy.setIntercepts(ics)
+		}
+		return
+	}
+	if hasIntercepts {
+		dlog.Debugf(f.lCtx, "Forward target changed from %s:%d to HTTP proxy", f.targetHost, f.targetPort)
+	} else {
+		dlog.Debugf(f.lCtx, "Forward target changed from HTTP proxy to %s:%d", f.targetHost, f.targetPort)
+	}
+
+	// Drop existing connections
+	f.tCancel()
+
+	// Set up new target and lifetime
+	f.tCtx, f.tCancel = context.WithCancel(f.lCtx)
+	if hasIntercepts {
+		f.httpProxy = newHTTPProxy(f.tCtx, f)
+	} else {
+		f.httpProxy = nil
+	}
+}
+
+func (f *tcp) matchingHTTPIntercept(r *http.Request) *HTTPIntercept {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, ic := range f.httpIntercepts {
+		if !isDraining(ic.InterceptInfo) && ic.Request.Matches(r.URL.Path, r.Header) {
+			return ic
+		}
+	}
+	return nil
+}
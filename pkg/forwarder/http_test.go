@@ -0,0 +1,132 @@
+package forwarder
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/pkg/iputil"
+	"github.com/telepresenceio/telepresence/v2/pkg/matcher"
+	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
+)
+
+// tunnelServer plays the part of the traffic-manager and the intercepting client. It dials the destination of
+// each tunnel that it receives.
+type tunnelServer struct {
+	manager.UnimplementedManagerServer
+	ids chan tunnel.ConnID
+}
+
+func (ts *tunnelServer) Tunnel(server manager.Manager_TunnelServer) error {
+	ctx := server.Context()
+	s, err := tunnel.NewServerStream(ctx, server)
+	if err != nil {
+		return err
+	}
+	ts.ids <- s.ID()
+
+	// The first message is the ID of the client session.
+	if _, err = s.Receive(ctx); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	d := tunnel.NewDialer(s, cancel)
+	d.Start(ctx)
+	<-d.Done()
+	return nil
+}
+
+func httpServer(t *testing.T, body string) (string, uint16) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, body)
+	}))
+	t.Cleanup(srv.Close)
+	ip, port, err := iputil.SplitToIPPort(srv.Listener.Addr())
+	require.NoError(t, err)
+	return ip.String(), port
+}
+
+func TestHTTPProxy(t *testing.T) {
+	ctx, cancel := context.WithCancel(dlog.NewTestContext(t, false))
+	defer cancel()
+
+	appHost, appPort := httpServer(t, "app")
+	laptopHost, laptopPort := httpServer(t, "laptop")
+
+	ts := &tunnelServer{ids: make(chan tunnel.ConnID, 10)}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	manager.RegisterManagerServer(srv, ts)
+	go func() { _ = srv.Serve(ln) }()
+	defer srv.Stop()
+	conn, err := grpc.DialContext(ctx, ln.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// The agent listens on all interfaces, so the listen address has no IP.
+	listen, err := net.ResolveTCPAddr("tcp", ":0")
+	require.NoError(t, err)
+	f := newTCP(listen, appHost, appPort).(*tcp)
+	f.SetManager(&manager.SessionInfo{SessionId: "agent"}, manager.NewManagerClient(conn), semver.MustParse("2.13.0"))
+	initCh := make(chan net.Addr, 1)
+	go func() { _ = f.Serve(ctx, initCh) }()
+	addr := <-initCh
+
+	rq, err := matcher.NewRequestFromMap(map[string]string{"x-user": "alice"})
+	require.NoError(t, err)
+	f.SetHTTPIntercepting([]*HTTPIntercept{{
+		InterceptInfo: &manager.InterceptInfo{
+			Id:            "client:echo",
+			Disposition:   manager.InterceptDispositionType_ACTIVE,
+			ClientSession: &manager.SessionInfo{SessionId: "client"},
+			Spec: &manager.InterceptSpec{
+				Name:       "echo",
+				Mechanism:  "http",
+				TargetHost: laptopHost,
+				TargetPort: int32(laptopPort),
+			},
+		},
+		Request: rq,
+	}})
+
+	get := func(user string) string {
+		t.Helper()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://127.0.0.1:"+portString(addr)+"/", nil)
+		require.NoError(t, err)
+		if user != "" {
+			req.Header.Set("X-User", user)
+		}
+		rsp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer rsp.Body.Close()
+		require.Equal(t, http.StatusOK, rsp.StatusCode)
+		body, err := io.ReadAll(rsp.Body)
+		require.NoError(t, err)
+		return string(body)
+	}
+
+	assert.Equal(t, "app", get(""))
+	assert.Equal(t, "app", get("bob"))
+	assert.Equal(t, "laptop", get("alice"))
+
+	id := <-ts.ids
+	assert.True(t, id.Source().Equal(net.IPv4(127, 0, 0, 1)), "the source is the local address of the connection, not %s", id.Source())
+	assert.False(t, id.IsProbe())
+}
+
+func portString(addr net.Addr) string {
+	_, port, _ := net.SplitHostPort(addr.String())
+	return port
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"net"
 	"sync"
+	"sync/atomic"
 
 	"github.com/blang/semver"
 
@@ -41,6 +42,22 @@ type interceptor struct {
 
 	intercept  *manager.InterceptInfo
 	mgrVersion semver.Version
+
+	// health is the health of the targets of intercepts that fall back to the intercepted container.
+	health map[string]*targetHealth
+
+	// connSeq is used when creating unique source ports for connections that originate in the forwarder.
+	connSeq uint32
+
+	// tunnels are the connections and requests that are tunneled to the clients of intercepts.
+	tunnels   map[string]*interceptTunnels
+	tunnelSeq uint64
+
+	// drains are the IDs of the draining intercepts, mapped to the mechanism that serves them.
+	drains map[string]string
+
+	// drainEnded, when set, is called with the ID of an intercept when its drain has ended.
+	drainEnded func(interceptID string)
 }
 
 func NewInterceptor(addr net.Addr, targetHost string, targetPort uint16) Interceptor {
@@ -54,6 +71,12 @@ func NewInterceptor(addr net.Addr, targetHost string, targetPort uint16) Interce
 	}
 }
 
+// nextSrcPort returns a source port for a connection that originates in the forwarder. Each such
+// connection must use a unique source port, or the client will consider it to be the same connection.
+func (f *interceptor) nextSrcPort() uint16 {
+	return uint16(1024 + atomic.AddUint32(&f.connSeq, 1)%(0x10000-1024))
+}
+
 func (f *interceptor) SetManager(sessionInfo *manager.SessionInfo, manager manager.ManagerClient, version semver.Version) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
@@ -97,6 +120,18 @@ func (f *interceptor) InterceptId() (id string) {
 func (f *interceptor) SetIntercepting(intercept *manager.InterceptInfo) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
+	f.pruneDrainsLocked("tcp", intercept)
+	if intercept != nil && isDraining(intercept) {
+		// New connections are sent to the target, but the existing connections aren't dropped.
+		f.setHealthChecksLocked("tcp")
+		if f.intercept != nil && f.intercept.Id == intercept.Id {
+			dlog.Debugf(f.lCtx, "Forward target changed from draining intercept '%s' to %s:%d", intercept.Spec.Name, f.targetHost, f.targetPort)
+			f.intercept = nil
+		}
+		f.startDrainLocked(intercept, "tcp")
+		return
+	}
+	f.setHealthChecksLocked("tcp", intercept)
 
 	iceptInfo := func(ii *manager.InterceptInfo) string {
 		is := ii.Spec
@@ -0,0 +1,176 @@
+package forwarder
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/pkg/ipproto"
+	"github.com/telepresenceio/telepresence/v2/pkg/iputil"
+	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
+)
+
+// mirrorBufferSize is the number of reads from a mirrored connection that can be buffered
+// for a mirror before the mirror gives up on that connection.
+const mirrorBufferSize = 64
+
+// MirrorInterceptor is implemented by interceptors that are capable of mirroring the connections
+// that they forward.
+type MirrorInterceptor interface {
+	Interceptor
+	SetMirroring([]*manager.InterceptInfo)
+}
+
+// SetMirroring sets the intercepts that will receive a copy of the inbound data of each connection
+// accepted by this forwarder. The connections themselves are forwarded as before and replies
+// from the mirroring clients are discarded.
+func (f *tcp) SetMirroring(mirrors []*manager.InterceptInfo) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(mirrors) == len(f.mirrors) {
+		same := true
+		for i, m := range mirrors {
+			if m.Id != f.mirrors[i].Id {
+				same = false
+				break
+			}
+		}
+		if same {
+			f.mirrors = mirrors
+			return
+		}
+	}
+	dlog.Debugf(f.lCtx, "Mirrors changed from %d to %d intercepts", len(f.mirrors), len(mirrors))
+
+	// Stop mirroring to the current mirrors. This doesn't affect forwarded connections.
+	if f.mCancel != nil {
+		f.mCancel()
+		f.mCancel = nil
+	}
+	f.mirrors = mirrors
+	if len(mirrors) > 0 {
+		f.mCtx, f.mCancel = context.WithCancel(f.lCtx)
+	}
+}
+
+// mirroredConn is a connection that sends a copy of everything that is read from it to its mirrors.
+type mirroredConn struct {
+	net.Conn
+	mirrors []*mirror
+	once    sync.Once
+}
+
+// mirror sends data to one mirroring intercept.
+type mirror struct {
+	sync.Mutex
+	ch     chan []byte
+	closed bool
+}
+
+func (f *tcp) newMirroredConn(ctx context.Context, conn net.Conn, iCepts []*manager.InterceptInfo) *mirroredConn {
+	addr := conn.RemoteAddr()
+	srcIp, srcPort, err := iputil.SplitToIPPort(addr)
+	if err != nil {
+		dlog.Errorf(ctx, "failed to parse mirrored source address %s: %v", addr, err)
+	}
+	mc := &mirroredConn{Conn: conn}
+	if err != nil {
+		return mc
+	}
+	mc.mirrors = make([]*mirror, len(iCepts))
+	for i, iCept := range iCepts {
+		spec := iCept.Spec
+		id := tunnel.NewConnID(ipproto.TCP, srcIp, iputil.Parse(spec.TargetHost), srcPort, uint16(spec.TargetPort))
+		m := &mirror{ch: make(chan []byte, mirrorBufferSize)}
+		mc.mirrors[i] = m
+		go f.serveMirror(ctx, m, id, iCept)
+	}
+	return mc
+}
+
+// serveMirror sends everything written to the mirror to the client of the given intercept, using
+// a tunnel with the given id. Data received from the client is discarded. The call returns when
+// the tunnel is closed.
+func (f *tcp) serveMirror(ctx context.Context, m *mirror, id tunnel.ConnID, iCept *manager.InterceptInfo) {
+	local, remote := net.Pipe()
+	defer remote.Close()
+	go func() {
+		_, _ = io.Copy(io.Discard, local)
+	}()
+	go func() {
+		// Closing the local end will make the tunnel endpoint read EOF and close the tunnel.
+		defer local.Close()
+		for data := range m.ch {
+			if _, err := local.Write(data); err != nil {
+				dlog.Debugf(ctx, "   MIRROR %s: %v", id, err)
+				m.close()
+				return
+			}
+		}
+	}()
+	if err := f.tunnelConn(ctx, remote, id, iCept); err != nil {
+		dlog.Errorf(ctx, "!! MIRROR %s: %v", id, err)
+	}
+	m.close()
+}
+
+func (m *mirror) close() {
+	m.Lock()
+	if !m.closed {
+		m.closed = true
+		close(m.ch)
+	}
+	m.Unlock()
+}
+
+// send sends a copy of the given data to the mirror without blocking. A mirror that
+// can't keep up is closed, because dropping data would corrupt the mirrored stream.
+func (m *mirror) send(data []byte) {
+	m.Lock()
+	defer m.Unlock()
+	if m.closed {
+		return
+	}
+	select {
+	case m.ch <- append([]byte(nil), data...):
+	default:
+		m.closed = true
+		close(m.ch)
+	}
+}
+
+func (c *mirroredConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	if n > 0 {
+		for _, m := range c.mirrors {
+			m.send(b[:n])
+		}
+	}
+	if err != nil {
+		c.closeMirrors()
+	}
+	return n, err
+}
+
+func (c *mirroredConn) CloseWrite() error {
+	if cw, ok := c.Conn.(interface{ CloseWrite() error }); ok {
+		return cw.CloseWrite()
+	}
+	return nil
+}
+
+func (c *mirroredConn) Close() error {
+	c.closeMirrors()
+	return c.Conn.Close()
+}
+
+func (c *mirroredConn) closeMirrors() {
+	c.once.Do(func() {
+		for _, m := range c.mirrors {
+			m.close()
+		}
+	})
+}
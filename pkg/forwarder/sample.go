@@ -0,0 +1,14 @@
+package forwarder
+
+import (
+	"math/rand"
+
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+)
+
+// sampled decides if a new connection or request is sent to the client of the given intercept.
+// Intercepts without a sample ratio, or with a ratio of one, intercept everything.
+func sampled(ii *manager.InterceptInfo) bool {
+	r := ii.Spec.SampleRatio
+	return r <= 0 || r >= 1 || rand.Float64() < r
+}
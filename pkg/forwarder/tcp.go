@@ -2,6 +2,8 @@ package forwarder
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -20,16 +22,43 @@ import (
 
 type tcp struct {
 	interceptor
+	httpIntercepts []*HTTPIntercept
+	httpProxy      *httpProxy
+
+	// mirrors are the intercepts that receive a copy of each accepted connection. The mirror context
+	// mCtx is cancelled when the mirrors change.
+	mirrors []*manager.InterceptInfo
+	mCtx    context.Context
+	mCancel context.CancelFunc
+
+	// terminating and originating are the TLS configs used when terminating the TLS of intercepted
+	// connections and when sending their cleartext to the target. No TLS is terminated when
+	// terminating is nil.
+	terminating *tls.Config
+	originating *tls.Config
 }
 
 func newTCP(listen net.Addr, targetHost string, targetPort uint16) Interceptor {
-	return &tcp{
+	f := &tcp{
 		interceptor: interceptor{
 			listenAddr: listen,
 			targetHost: targetHost,
 			targetPort: targetPort,
 		},
 	}
+	f.drainEnded = f.closeDrainedTunnels
+	return f
+}
+
+// closeDrainedTunnels closes the connections that the HTTP proxy keeps tunneled to the client of the
+// intercept with the given ID after its drain has ended.
+func (f *tcp) closeDrainedTunnels(interceptID string) {
+	f.mu.Lock()
+	hp := f.httpProxy
+	f.mu.Unlock()
+	if hp != nil {
+		hp.drainEnded(interceptID)
+	}
 }
 
 func (f *tcp) Serve(ctx context.Context, initCh chan<- net.Addr) error {
@@ -90,7 +119,13 @@ func (f *tcp) listen(ctx context.Context) (*net.TCPListener, error) {
 	return net.ListenTCP("tcp", listenAddr.(*net.TCPAddr))
 }
 
-func (f *tcp) forwardConn(clientConn *net.TCPConn) error {
+// halfCloser is a connection that can close its write side while it continues to read.
+type halfCloser interface {
+	net.Conn
+	CloseWrite() error
+}
+
+func (f *tcp) forwardConn(tcpConn *net.TCPConn) error {
 	f.mu.Lock()
 	ctx := f.tCtx
 	ctx, span := otel.Tracer("").Start(ctx, "forwardConn")
@@ -98,9 +133,37 @@ func (f *tcp) forwardConn(clientConn *net.TCPConn) error {
 	targetHost := f.targetHost
 	targetPort := f.targetPort
 	intercept := f.intercept
+	hp := f.httpProxy
+	mirrors := f.mirrors
+	mCtx := f.mCtx
+	terminating := f.terminating
+	originating := f.originating
 	f.mu.Unlock()
-	if intercept != nil {
-		return f.interceptConn(ctx, clientConn, intercept)
+
+	isSampled := intercept != nil && sampled(intercept)
+	var clientConn halfCloser = tcpConn
+	var tlsConn *tls.Conn
+	if terminating != nil && (isSampled || hp != nil || len(mirrors) > 0) {
+		// Only connections that are read by something other than the target are terminated.
+		clientConn, tlsConn = terminateTLS(tcpConn, terminating)
+	}
+	if len(mirrors) > 0 {
+		// The mirrors are closed when the connection is closed or reaches EOF.
+		clientConn = f.newMirroredConn(mCtx, clientConn, mirrors)
+	}
+	switch {
+	case intercept != nil && !isSampled:
+		dlog.Tracef(ctx, "Connection not sampled by intercept %q, forwarding to %s:%d", intercept.Spec.Name, targetHost, targetPort)
+	case intercept != nil:
+		if !f.fallbackActive(intercept) {
+			if err := f.interceptConn(ctx, clientConn, intercept); !errors.Is(err, errTargetUnreachable) {
+				return err
+			}
+		}
+		dlog.Debugf(ctx, "Intercept %q falls back to %s:%d", intercept.Spec.Name, targetHost, targetPort)
+	case hp != nil:
+		hp.serveConn(clientConn, tlsConn != nil)
+		return nil
 	}
 
 	targetAddr, err := net.ResolveTCPAddr("tcp", fmt.Sprintf("%s:%d", targetHost, targetPort))
@@ -120,10 +183,27 @@ func (f *tcp) forwardConn(clientConn *net.TCPConn) error {
 
 	defer clientConn.Close()
 
-	targetConn, err := net.DialTCP("tcp", nil, targetAddr)
+	var targetConn halfCloser
+	targetConn, err = net.DialTCP("tcp", nil, targetAddr)
 	if err != nil {
 		return fmt.Errorf("error on dial: %w", err)
 	}
+	if tlsConn != nil {
+		// The target expects the TLS that was terminated, so the cleartext is encrypted again using
+		// the server name and the application protocol that the client asked for.
+		if err = tlsConn.HandshakeContext(ctx); err != nil {
+			_ = targetConn.Close()
+			return fmt.Errorf("TLS handshake with %s failed: %w", clientConn.RemoteAddr(), err)
+		}
+		cs := tlsConn.ConnectionState()
+		var protos []string
+		if cs.NegotiatedProtocol != "" {
+			protos = []string{cs.NegotiatedProtocol}
+		}
+		if targetConn, err = originateTLS(ctx, targetConn, originatingConfig(originating, cs.ServerName, protos...)); err != nil {
+			return err
+		}
+	}
 	defer targetConn.Close()
 
 	done := make(chan struct{})
@@ -172,24 +252,53 @@ func (f *interceptor) interceptConn(ctx context.Context, conn net.Conn, iCept *m
 	destIp := iputil.Parse(spec.TargetHost)
 	id := tunnel.NewConnID(ipproto.Parse(addr.Network()), srcIp, destIp, srcPort, uint16(spec.TargetPort))
 	id.SpanRecord(span)
+	ctx, done := f.trackTunnel(ctx, iCept)
+	defer done()
+	return f.tunnelConn(ctx, conn, id, iCept)
+}
 
-	ms, err := f.manager.Tunnel(ctx)
-	if err != nil {
-		return fmt.Errorf("call to manager.Tunnel() failed. Id %s: %v", id, err)
-	}
-
+// tunnelConn dispatches the given connection to the client that owns the intercept using a tunnel
+// with the given id. The call returns when the connection is closed, or with errTargetUnreachable
+// and the connection untouched when an intercept that uses fallback can't reach its target.
+func (f *interceptor) tunnelConn(ctx context.Context, conn net.Conn, id tunnel.ConnID, iCept *manager.InterceptInfo) error {
 	ctx, cancel := context.WithCancel(ctx)
-	s, err := tunnel.NewClientStream(ctx, ms, id, f.sessionInfo.SessionId, time.Duration(spec.RoundtripLatency), time.Duration(spec.DialTimeout))
+	s, err := f.openTunnel(ctx, id, iCept)
 	if err != nil {
 		cancel()
 		return err
 	}
-	if err = s.Send(ctx, tunnel.SessionMessage(iCept.ClientSession.SessionId)); err != nil {
-		cancel()
-		return fmt.Errorf("unable to send client session id. Id %s: %v", id, err)
-	}
 	d := tunnel.NewConnEndpoint(s, conn, cancel)
 	d.Start(ctx)
 	<-d.Done()
 	return nil
 }
+
+// openTunnel opens a tunnel with the given id to the client that owns the intercept. The tunnel lives
+// until the context is cancelled. When the intercept uses fallback, openTunnel waits for the client to
+// dial the target and returns errTargetUnreachable if that fails.
+func (f *interceptor) openTunnel(ctx context.Context, id tunnel.ConnID, iCept *manager.InterceptInfo) (tunnel.Stream, error) {
+	f.mu.Lock()
+	mc := f.manager
+	sessionID := f.sessionInfo.SessionId
+	f.mu.Unlock()
+
+	spec := iCept.Spec
+	ms, err := mc.Tunnel(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("call to manager.Tunnel() failed. Id %s: %v", id, err)
+	}
+
+	s, err := tunnel.NewClientStream(ctx, ms, id, sessionID, time.Duration(spec.RoundtripLatency), time.Duration(spec.DialTimeout))
+	if err != nil {
+		return nil, err
+	}
+	if err = s.Send(ctx, tunnel.SessionMessage(iCept.ClientSession.SessionId)); err != nil {
+		return nil, fmt.Errorf("unable to send client session id. Id %s: %v", id, err)
+	}
+	if usesFallback(iCept) {
+		if err = f.awaitDial(ctx, s, iCept); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
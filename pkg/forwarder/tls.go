@@ -0,0 +1,110 @@
+package forwarder
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"time"
+)
+
+// tlsSniffTimeout is how long a forwarder that terminates TLS waits for the first byte of a connection
+// before it concludes that the connection isn't TLS. A TLS client sends its ClientHello as soon as it has
+// connected. Protocols where the server speaks first will never send a handshake, so they are delayed
+// by this long before they reach their target.
+const tlsSniffTimeout = 100 * time.Millisecond
+
+// recordTypeHandshake is the type of the TLS record that starts every TLS connection.
+const recordTypeHandshake = 0x16
+
+// TLSInterceptor is implemented by interceptors that are capable of terminating the TLS of the connections
+// that they intercept, so that the intercepting clients receive cleartext.
+type TLSInterceptor interface {
+	Interceptor
+	SetTLS(terminating, originating *tls.Config)
+}
+
+// SetTLS makes this forwarder terminate the TLS of intercepted connections using the given terminating config.
+// Cleartext from terminated connections that is sent to the forwarder's target is encrypted again using the
+// given originating config, or using a config without a client certificate when it is nil. Connections that
+// aren't intercepted, and connections that don't start with a TLS handshake, are forwarded untouched.
+func (f *tcp) SetTLS(terminating, originating *tls.Config) {
+	if terminating != nil && len(terminating.NextProtos) == 0 {
+		// HTTP/1.1 is preferred, because it works with cleartext HTTP servers on the client side. HTTP/2
+		// is offered to clients that require it, such as gRPC.
+		terminating = terminating.Clone()
+		terminating.NextProtos = []string{"http/1.1", "h2"}
+	}
+	f.mu.Lock()
+	f.terminating = terminating
+	f.originating = originating
+	f.mu.Unlock()
+}
+
+// sniffedConn is a connection whose first bytes have been peeked at.
+type sniffedConn struct {
+	*net.TCPConn
+	r *bufio.Reader
+}
+
+func (c *sniffedConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
+
+// terminateTLS returns a connection that terminates the TLS of the given connection using the given config
+// when the connection starts with a TLS handshake. The returned *tls.Conn is nil when it doesn't, and the
+// returned connection then produces the same data as the given connection.
+func terminateTLS(conn *net.TCPConn, config *tls.Config) (halfCloser, *tls.Conn) {
+	sc := &sniffedConn{TCPConn: conn, r: bufio.NewReader(conn)}
+	_ = conn.SetReadDeadline(time.Now().Add(tlsSniffTimeout))
+	b, err := sc.r.Peek(1)
+	_ = conn.SetReadDeadline(time.Time{})
+	if err != nil || b[0] != recordTypeHandshake {
+		return sc, nil
+	}
+	tc := tls.Server(sc, config)
+	return tc, tc
+}
+
+// originatingConfig returns the config used when originating TLS to the forwarder's target using the given
+// server name and application protocols. The target is dialed using a loopback address, so its certificate
+// can't be verified against a host name. It is verified against the root CAs of the originating config
+// when there are any, and not verified at all otherwise.
+func originatingConfig(originating *tls.Config, serverName string, protos ...string) *tls.Config {
+	var config *tls.Config
+	if originating == nil {
+		config = &tls.Config{}
+	} else {
+		config = originating.Clone()
+	}
+	config.ServerName = serverName
+	config.NextProtos = protos
+	config.InsecureSkipVerify = true
+	if roots := config.RootCAs; roots != nil {
+		config.VerifyConnection = func(cs tls.ConnectionState) error {
+			if len(cs.PeerCertificates) == 0 {
+				return fmt.Errorf("target %s presented no certificate", cs.ServerName)
+			}
+			opts := x509.VerifyOptions{Roots: roots, Intermediates: x509.NewCertPool()}
+			for _, cert := range cs.PeerCertificates[1:] {
+				opts.Intermediates.AddCert(cert)
+			}
+			_, err := cs.PeerCertificates[0].Verify(opts)
+			return err
+		}
+	}
+	return config
+}
+
+// originateTLS performs a TLS handshake over the given connection using the given config. The given connection
+// is closed if the handshake fails.
+func originateTLS(ctx context.Context, conn net.Conn, config *tls.Config) (*tls.Conn, error) {
+	tc := tls.Client(conn, config)
+	if err := tc.HandshakeContext(ctx); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("TLS handshake with %s failed: %w", conn.RemoteAddr(), err)
+	}
+	return tc, nil
+}
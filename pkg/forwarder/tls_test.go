@@ -0,0 +1,120 @@
+package forwarder
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/telepresenceio/telepresence/v2/pkg/install"
+)
+
+func TestTerminateTLS(t *testing.T) {
+	crtPEM, keyPEM, caPEM, err := install.GenerateKeys("ambassador")
+	require.NoError(t, err)
+	cert, err := tls.X509KeyPair(crtPEM, keyPEM)
+	require.NoError(t, err)
+	ca := x509.NewCertPool()
+	require.True(t, ca.AppendCertsFromPEM(caPEM))
+
+	l, err := net.ListenTCP("tcp", &net.TCPAddr{IP: net.IP{127, 0, 0, 1}})
+	require.NoError(t, err)
+	defer l.Close()
+
+	// The server echoes the first line that it reads, and tells if that line was encrypted.
+	terminating := &tls.Config{Certificates: []tls.Certificate{cert}}
+	go func() {
+		for {
+			tcpConn, err := l.AcceptTCP()
+			if err != nil {
+				return
+			}
+			go func() {
+				conn, tlsConn := terminateTLS(tcpConn, terminating)
+				defer conn.Close()
+				line, err := bufio.NewReader(conn).ReadString('\n')
+				if err != nil {
+					return
+				}
+				if tlsConn != nil {
+					line = "tls " + line
+				}
+				_, _ = conn.Write([]byte(line))
+			}()
+		}
+	}()
+
+	roundTrip := func(t *testing.T, conn net.Conn) string {
+		t.Helper()
+		defer conn.Close()
+		_, err := conn.Write([]byte("hello\n"))
+		require.NoError(t, err)
+		line, err := bufio.NewReader(conn).ReadString('\n')
+		require.NoError(t, err)
+		return line
+	}
+	ctx := context.Background()
+
+	t.Run("cleartext", func(t *testing.T) {
+		conn, err := net.Dial("tcp", l.Addr().String())
+		require.NoError(t, err)
+		assert.Equal(t, "hello\n", roundTrip(t, conn))
+	})
+
+	t.Run("verified", func(t *testing.T) {
+		conn, err := net.Dial("tcp", l.Addr().String())
+		require.NoError(t, err)
+		tc, err := originateTLS(ctx, conn, originatingConfig(&tls.Config{RootCAs: ca}, ""))
+		require.NoError(t, err)
+		assert.Equal(t, "tls hello\n", roundTrip(t, tc))
+	})
+
+	t.Run("unverified", func(t *testing.T) {
+		conn, err := net.Dial("tcp", l.Addr().String())
+		require.NoError(t, err)
+		tc, err := originateTLS(ctx, conn, originatingConfig(nil, ""))
+		require.NoError(t, err)
+		assert.Equal(t, "tls hello\n", roundTrip(t, tc))
+	})
+
+	t.Run("unknown CA", func(t *testing.T) {
+		conn, err := net.Dial("tcp", l.Addr().String())
+		require.NoError(t, err)
+		_, err = originateTLS(ctx, conn, originatingConfig(&tls.Config{RootCAs: x509.NewCertPool()}, ""))
+		assert.Error(t, err)
+	})
+}
+
+func TestTerminateTLS_serverFirst(t *testing.T) {
+	l, err := net.ListenTCP("tcp", &net.TCPAddr{IP: net.IP{127, 0, 0, 1}})
+	require.NoError(t, err)
+	defer l.Close()
+
+	// The server greets the client before the client sends anything.
+	go func() {
+		tcpConn, err := l.AcceptTCP()
+		if err != nil {
+			return
+		}
+		conn, tlsConn := terminateTLS(tcpConn, &tls.Config{})
+		defer conn.Close()
+		if tlsConn == nil {
+			_, _ = conn.Write([]byte("220 ready\n"))
+		}
+	}()
+
+	conn, err := net.Dial("tcp", l.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	start := time.Now()
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, "220 ready\n", line)
+	assert.Less(t, time.Since(start), 5*tlsSniffTimeout)
+}
@@ -0,0 +1,179 @@
+// Package recording contains the file format used when recording the traffic of intercepted
+// connections, along with the means to write, read, and replay such recordings.
+//
+// Each connection is recorded in a file of its own. The first line of the file is a JSON
+// encoded Header. Each subsequent line is a JSON encoded Event.
+package recording
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/telepresenceio/telepresence/v2/pkg/ipproto"
+	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
+)
+
+// FileSuffix is the suffix used for all recording files.
+const FileSuffix = ".jsonl"
+
+// Direction is the direction in which recorded data was sent.
+type Direction string
+
+const (
+	// In is data that was sent from the cluster to the intercept target on the workstation.
+	In = Direction("in")
+
+	// Out is data that was sent from the intercept target on the workstation back to the cluster.
+	Out = Direction("out")
+)
+
+// Header describes the recorded connection.
+type Header struct {
+	ConnID      string    `json:"connId"`
+	Protocol    string    `json:"protocol"`
+	Source      string    `json:"source"`
+	Destination string    `json:"destination"`
+	Intercept   string    `json:"intercept,omitempty"`
+	Start       time.Time `json:"start"`
+}
+
+// Event is data that was sent in one direction at a given offset from the start of the connection.
+type Event struct {
+	Offset    time.Duration `json:"offset"`
+	Direction Direction     `json:"direction"`
+	Data      []byte        `json:"data"`
+}
+
+// Writer writes a recording of one connection to a file.
+type Writer struct {
+	sync.Mutex
+	file  *os.File
+	enc   *json.Encoder
+	start time.Time
+	err   error
+}
+
+// Create creates a new recording file in the given directory for the connection with the given id.
+// The directory is created if it doesn't exist. Recordings contain the payload of the connections, so
+// only the owner can read them.
+func Create(dir string, id tunnel.ConnID, intercept string) (*Writer, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	name := fmt.Sprintf("%s-%d%s", start.UTC().Format("20060102T150405.000000000"), id.SourcePort(), FileSuffix)
+	f, err := os.OpenFile(filepath.Join(dir, name), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	w := &Writer{file: f, enc: json.NewEncoder(f), start: start}
+	err = w.enc.Encode(&Header{
+		ConnID:      id.String(),
+		Protocol:    ipproto.String(id.Protocol()),
+		Source:      id.SourceAddr().String(),
+		Destination: id.DestinationAddr().String(),
+		Intercept:   intercept,
+		Start:       start,
+	})
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+// Name returns the name of the recording file.
+func (w *Writer) Name() string {
+	return w.file.Name()
+}
+
+// Record records the given data as sent in the given direction. Errors are retained and
+// returned by Close, so that a failing recording never disturbs the connection.
+func (w *Writer) Record(dir Direction, data []byte) {
+	if len(data) == 0 {
+		return
+	}
+	w.Lock()
+	defer w.Unlock()
+	if w.err == nil {
+		w.err = w.enc.Encode(&Event{Offset: time.Since(w.start), Direction: dir, Data: data})
+	}
+}
+
+// Close closes the recording file and returns the first error that occurred while recording.
+func (w *Writer) Close() error {
+	w.Lock()
+	defer w.Unlock()
+	if err := w.file.Close(); err != nil && w.err == nil {
+		w.err = err
+	}
+	return w.err
+}
+
+// Reader reads a recording from a file.
+type Reader struct {
+	file   *os.File
+	dec    *json.Decoder
+	header Header
+}
+
+// Open opens the given recording file and reads its header.
+func Open(file string) (*Reader, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	r := &Reader{file: f, dec: json.NewDecoder(f)}
+	if err = r.dec.Decode(&r.header); err != nil {
+		_ = f.Close()
+		return nil, fmt.Errorf("%s is not a valid recording: %w", file, err)
+	}
+	return r, nil
+}
+
+// Header returns the header of the recording.
+func (r *Reader) Header() *Header {
+	return &r.header
+}
+
+// Next returns the next recorded event, or io.EOF when there are no more events.
+func (r *Reader) Next() (*Event, error) {
+	var ev Event
+	if err := r.dec.Decode(&ev); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("%s is not a valid recording: %w", r.file.Name(), err)
+	}
+	return &ev, nil
+}
+
+// Close closes the recording file.
+func (r *Reader) Close() error {
+	return r.file.Close()
+}
+
+// Files returns the recording files found in the given directory, in the order that the
+// recorded connections were established.
+func Files(dir string) ([]string, error) {
+	des, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, de := range des {
+		if de.Type().IsRegular() && strings.HasSuffix(de.Name(), FileSuffix) {
+			files = append(files, filepath.Join(dir, de.Name()))
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
@@ -0,0 +1,143 @@
+package recording_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/telepresenceio/telepresence/v2/pkg/ipproto"
+	"github.com/telepresenceio/telepresence/v2/pkg/recording"
+	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
+)
+
+func record(ctx context.Context, t *testing.T, dir string, port uint16) {
+	id := tunnel.NewConnID(ipproto.TCP, net.IP{10, 0, 0, 1}, net.IP{127, 0, 0, 1}, 34567, port)
+	w, err := recording.Create(dir, id, "echo")
+	require.NoError(t, err)
+
+	a, b := tunnel.NewPipe(id, "session-1")
+	rs := recording.NewStream(a, w)
+
+	// Data from the cluster
+	for _, m := range []tunnel.Message{
+		tunnel.NewMessage(tunnel.Normal, []byte("hello ")),
+		tunnel.NewMessage(tunnel.Normal, []byte("world")),
+		tunnel.NewMessage(tunnel.KeepAlive, nil),
+	} {
+		require.NoError(t, b.Send(ctx, m))
+		_, err = rs.Receive(ctx)
+		require.NoError(t, err)
+	}
+
+	// Reply from the intercept target
+	require.NoError(t, rs.Send(ctx, tunnel.NewMessage(tunnel.Normal, []byte("hello world"))))
+	_, err = b.Receive(ctx)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+}
+
+func TestRecording(t *testing.T) {
+	ctx := dlog.NewTestContext(t, false)
+	dir := t.TempDir()
+	record(ctx, t, dir, 8080)
+
+	files, err := recording.Files(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	r, err := recording.Open(files[0])
+	require.NoError(t, err)
+	defer r.Close()
+
+	hdr := r.Header()
+	assert.Equal(t, "tcp", hdr.Protocol)
+	assert.Equal(t, "10.0.0.1:34567", hdr.Source)
+	assert.Equal(t, "127.0.0.1:8080", hdr.Destination)
+	assert.Equal(t, "echo", hdr.Intercept)
+
+	var evs []*recording.Event
+	for {
+		ev, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		evs = append(evs, ev)
+	}
+	require.Len(t, evs, 3)
+	assert.Equal(t, recording.In, evs[0].Direction)
+	assert.Equal(t, "hello ", string(evs[0].Data))
+	assert.Equal(t, recording.In, evs[1].Direction)
+	assert.Equal(t, "world", string(evs[1].Data))
+	assert.Equal(t, recording.Out, evs[2].Direction)
+	assert.Equal(t, "hello world", string(evs[2].Data))
+	assert.LessOrEqual(t, evs[0].Offset, evs[2].Offset)
+}
+
+func TestReplay(t *testing.T) {
+	ctx := dlog.NewTestContext(t, false)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_, _ = io.Copy(conn, conn)
+			}()
+		}
+	}()
+
+	dir := t.TempDir()
+	record(ctx, t, dir, uint16(l.Addr().(*net.TCPAddr).Port))
+	files, err := recording.Files(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	// Replay to the recorded destination
+	result, err := recording.Replay(ctx, files[0], "", 0)
+	require.NoError(t, err)
+	assert.Equal(t, &recording.Result{Sent: 11, Received: 11, Recorded: 11}, result)
+
+	// Replay to an explicit destination
+	result, err = recording.Replay(ctx, files[0], l.Addr().String(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, 11, result.Received)
+}
+
+func TestRecordingPermissions(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file permissions are not supported on windows")
+	}
+	ctx := dlog.NewTestContext(t, false)
+	dir := filepath.Join(t.TempDir(), "recordings")
+	record(ctx, t, dir, 8080)
+
+	st, err := os.Stat(dir)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o700), st.Mode().Perm())
+
+	files, err := recording.Files(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	st, err = os.Stat(files[0])
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), st.Mode().Perm())
+}
@@ -0,0 +1,98 @@
+package recording
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+)
+
+// replayDrainTimeout is how long Replay waits for the target to close the connection after all
+// recorded data has been sent.
+const replayDrainTimeout = 5 * time.Second
+
+// Result summarizes the replay of one recorded connection.
+type Result struct {
+	// Sent is the number of bytes sent to the target.
+	Sent int
+
+	// Received is the number of bytes received from the target.
+	Received int
+
+	// Recorded is the number of bytes that the original target sent back during the recording.
+	Recorded int
+}
+
+// Replay sends the data that was recorded as In to a connection that is dialed to the given address.
+// Data is sent with the same timing as in the recording, adjusted by the given speed. A speed of zero
+// means that all data is sent as fast as possible.
+func Replay(ctx context.Context, file, addr string, speed float64) (*Result, error) {
+	r, err := Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	hdr := r.Header()
+	if addr == "" {
+		addr = hdr.Destination
+	}
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, hdr.Protocol, addr)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	result := &Result{}
+	received := make(chan int, 1)
+	go func() {
+		n, _ := io.Copy(io.Discard, conn)
+		received <- int(n)
+	}()
+
+	start := time.Now()
+	for {
+		ev, err := r.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return result, err
+		}
+		if speed > 0 {
+			at := start.Add(time.Duration(float64(ev.Offset) / speed))
+			select {
+			case <-ctx.Done():
+				return result, ctx.Err()
+			case <-time.After(time.Until(at)):
+			}
+		}
+		switch ev.Direction {
+		case In:
+			n, err := conn.Write(ev.Data)
+			result.Sent += n
+			if err != nil {
+				return result, fmt.Errorf("failed to write to %s: %w", addr, err)
+			}
+		case Out:
+			result.Recorded += len(ev.Data)
+		}
+	}
+
+	// Signal that no more data will arrive and wait for the target to close its side.
+	if cw, ok := conn.(interface{ CloseWrite() error }); ok {
+		_ = cw.CloseWrite()
+	}
+	select {
+	case <-ctx.Done():
+		return result, ctx.Err()
+	case result.Received = <-received:
+	case <-time.After(replayDrainTimeout):
+		_ = conn.Close()
+		result.Received = <-received
+	}
+	return result, nil
+}
@@ -0,0 +1,36 @@
+package recording
+
+import (
+	"context"
+
+	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
+)
+
+// stream is a tunnel.Stream that records the payload of all normal messages that pass through it.
+type stream struct {
+	tunnel.Stream
+	w *Writer
+}
+
+// NewStream returns a tunnel.Stream that records the data that passes through the given stream
+// using the given Writer. Data received from the stream is recorded as In, and data sent to the
+// stream is recorded as Out.
+func NewStream(s tunnel.Stream, w *Writer) tunnel.Stream {
+	return &stream{Stream: s, w: w}
+}
+
+func (s *stream) Receive(ctx context.Context) (tunnel.Message, error) {
+	m, err := s.Stream.Receive(ctx)
+	if err == nil && m.Code() == tunnel.Normal {
+		s.w.Record(In, m.Payload())
+	}
+	return m, err
+}
+
+func (s *stream) Send(ctx context.Context, m tunnel.Message) error {
+	err := s.Stream.Send(ctx, m)
+	if err == nil && m.Code() == tunnel.Normal {
+		s.w.Record(Out, m.Payload())
+	}
+	return err
+}
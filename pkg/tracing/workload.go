@@ -8,6 +8,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/datawire/k8sapi/pkg/k8sapi"
+	"github.com/telepresenceio/telepresence/v2/pkg/workload"
 )
 
 func RecordWorkloadInfo(span trace.Span, wl k8sapi.Workload) {
@@ -27,6 +28,9 @@ func RecordWorkloadInfo(span trace.Span, wl k8sapi.Workload) {
 //  1. Deployments
 //  2. ReplicaSets
 //  3. StatefulSets
+//  4. DaemonSets
+//  5. Argo Rollouts
+//  6. Bare Pods
 //
 // The first match is returned.
 func GetWorkload(c context.Context, name, namespace, workloadKind string) (obj k8sapi.Workload, err error) {
@@ -39,5 +43,5 @@ func GetWorkload(c context.Context, name, namespace, workloadKind string) (obj k
 	)
 	defer EndAndRecord(span, err)
 
-	return k8sapi.GetWorkload(c, name, namespace, workloadKind)
+	return workload.GetWorkload(c, name, namespace, workloadKind)
 }
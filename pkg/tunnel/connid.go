@@ -56,6 +56,22 @@ func NewZeroID() ConnID {
 	return ConnID(make([]byte, 13))
 }
 
+// NewProbeConnID returns a new ConnID for a health probe of the given destination. A probe uses the
+// unspecified address as its source, which no real connection has, so that it can be told apart from
+// the connections that it probes for.
+func NewProbeConnID(proto int, dst net.IP, srcPort, dstPort uint16) ConnID {
+	src := net.IPv6unspecified
+	if dst.To4() != nil {
+		src = net.IPv4zero
+	}
+	return NewConnID(proto, src, dst, srcPort, dstPort)
+}
+
+// IsProbe returns true if this is the ConnID of a health probe.
+func (id ConnID) IsProbe() bool {
+	return id.Source().IsUnspecified()
+}
+
 // IsIPv4 returns true if the source and destination of this ConnID are IPv4.
 func (id ConnID) IsIPv4() bool {
 	return len(id) == 13
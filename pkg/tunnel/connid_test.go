@@ -0,0 +1,23 @@
+package tunnel
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/telepresenceio/telepresence/v2/pkg/ipproto"
+)
+
+func TestConnID_IsProbe(t *testing.T) {
+	probe := NewProbeConnID(ipproto.TCP, net.IP{127, 0, 0, 1}, 34567, 8080)
+	assert.True(t, probe.IsProbe())
+	assert.True(t, probe.IsIPv4())
+	assert.Equal(t, "127.0.0.1:8080", probe.DestinationAddr().String())
+
+	probe = NewProbeConnID(ipproto.TCP, net.ParseIP("::1"), 34567, 8080)
+	assert.True(t, probe.IsProbe())
+	assert.False(t, probe.IsIPv4())
+
+	assert.False(t, NewConnID(ipproto.TCP, net.IP{10, 0, 0, 1}, net.IP{127, 0, 0, 1}, 34567, 8080).IsProbe())
+}
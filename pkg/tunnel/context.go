@@ -16,3 +16,20 @@ func GetPool(ctx context.Context) *Pool {
 	}
 	return pool
 }
+
+// StreamWrapper wraps a Stream that is dialed on behalf of a peer. The returned function is
+// called when the dialer that uses the stream is done.
+type StreamWrapper func(context.Context, Stream) (Stream, func())
+
+type streamWrapperKey struct{}
+
+// WithStreamWrapper returns a context with the given StreamWrapper. The wrapper is applied
+// to the streams that are created by DialWaitLoop.
+func WithStreamWrapper(ctx context.Context, sw StreamWrapper) context.Context {
+	return context.WithValue(ctx, streamWrapperKey{}, sw)
+}
+
+func getStreamWrapper(ctx context.Context) StreamWrapper {
+	sw, _ := ctx.Value(streamWrapperKey{}).(StreamWrapper)
+	return sw
+}
@@ -353,6 +353,11 @@ func dialRespond(ctx context.Context, manager rpc.ManagerClient, dr *rpc.DialReq
 		cancel()
 		return
 	}
+	if sw := getStreamWrapper(ctx); sw != nil {
+		var done func()
+		s, done = sw(ctx, s)
+		defer done()
+	}
 	d := NewDialer(s, cancel)
 	d.Start(ctx)
 	<-d.Done()
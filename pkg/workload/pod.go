@@ -0,0 +1,149 @@
+package workload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	core "k8s.io/api/core/v1"
+	errors2 "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	typedCore "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+)
+
+// ErrNotBarePod is returned by GetPod when the pod is controlled by another object.
+var ErrNotBarePod = errors.New("not a bare pod")
+
+// pod is a bare pod, i.e. a pod that isn't controlled by some other object, used as a Workload.
+type pod struct {
+	*core.Pod
+}
+
+// GetPod returns the bare pod with the given name and namespace. An error is returned if the pod is
+// controlled by another object.
+func GetPod(c context.Context, name, namespace string) (k8sapi.Workload, error) {
+	p, err := pods(c, namespace).Get(c, name, meta.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if or := meta.GetControllerOf(p); or != nil {
+		return nil, fmt.Errorf("pod %s.%s is controlled by %s %s: %w", name, namespace, or.Kind, or.Name, ErrNotBarePod)
+	}
+	return &pod{p}, nil
+}
+
+// BarePods returns all bare pods found in the given Namespace. An empty list is returned if the caller
+// isn't permitted to list pods.
+func BarePods(c context.Context, namespace string, labelSelector labels.Set) ([]k8sapi.Workload, error) {
+	ls, err := pods(c, namespace).List(c, listOptions(labelSelector))
+	if err != nil {
+		if errors2.IsForbidden(err) {
+			dlog.Debugf(c, "not permitted to list pods in namespace %s: %v", namespace, err)
+			return nil, nil
+		}
+		return nil, err
+	}
+	is := ls.Items
+	os := make([]k8sapi.Workload, 0, len(is))
+	for i := range is {
+		if IsBarePod(&is[i]) {
+			os = append(os, Pod(&is[i]))
+		}
+	}
+	return os, nil
+}
+
+// IsBarePod returns true if the given pod isn't controlled by another object.
+func IsBarePod(p *core.Pod) bool {
+	return meta.GetControllerOf(p) == nil
+}
+
+func Pod(p *core.Pod) k8sapi.Workload {
+	return &pod{p}
+}
+
+// PodImpl casts the given Object as a *core.Pod and returns
+// it together with a status flag indicating whether the cast was possible.
+func PodImpl(o k8sapi.Object) (*core.Pod, bool) {
+	if s, ok := o.(*pod); ok {
+		return s.Pod, true
+	}
+	return nil, false
+}
+
+func pods(c context.Context, namespace string) typedCore.PodInterface {
+	return k8sapi.GetK8sInterface(c).CoreV1().Pods(namespace)
+}
+
+func (o *pod) ki(c context.Context) typedCore.PodInterface {
+	return pods(c, o.Namespace)
+}
+
+func (o *pod) GetKind() string {
+	return PodKind
+}
+
+func (o *pod) Delete(c context.Context) error {
+	return o.ki(c).Delete(c, o.Name, meta.DeleteOptions{})
+}
+
+// GetPodTemplate returns a template that reflects the pod's metadata and spec. Modifications
+// to the returned template are not reflected in the pod.
+func (o *pod) GetPodTemplate() *core.PodTemplateSpec {
+	return &core.PodTemplateSpec{
+		ObjectMeta: o.ObjectMeta,
+		Spec:       o.Spec,
+	}
+}
+
+func (o *pod) Patch(c context.Context, pt types.PatchType, data []byte, subresources ...string) error {
+	d, err := o.ki(c).Patch(c, o.Name, pt, data, meta.PatchOptions{}, subresources...)
+	if err == nil {
+		o.Pod = d
+	}
+	return err
+}
+
+func (o *pod) Refresh(c context.Context) error {
+	d, err := o.ki(c).Get(c, o.Name, meta.GetOptions{})
+	if err == nil {
+		o.Pod = d
+	}
+	return err
+}
+
+func (o *pod) Replicas() int {
+	return 1
+}
+
+// Selector returns a selector that matches the labels of the pod.
+func (o *pod) Selector() (labels.Selector, error) {
+	return labels.SelectorFromSet(o.Labels), nil
+}
+
+func (o *pod) Update(c context.Context) error {
+	d, err := o.ki(c).Update(c, o.Pod, meta.UpdateOptions{})
+	if err == nil {
+		o.Pod = d
+	}
+	return err
+}
+
+// Updated returns true when the pod is running and ready. A pod's generation is never
+// updated, so the origGeneration is ignored.
+func (o *pod) Updated(_ int64) bool {
+	if o.Status.Phase != core.PodRunning {
+		return false
+	}
+	for _, c := range o.Status.Conditions {
+		if c.Type == core.PodReady {
+			return c.Status == core.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,228 @@
+package workload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	core "k8s.io/api/core/v1"
+	errors2 "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+)
+
+// RolloutResource is the resource of an Argo Rollout.
+var RolloutResource = schema.GroupVersionResource{ //nolint:gochecknoglobals // constant
+	Group:    "argoproj.io",
+	Version:  "v1alpha1",
+	Resource: "rollouts",
+}
+
+// errNoDynamicInterface is returned when Rollouts are accessed using a context that lacks a dynamic.Interface.
+var errNoDynamicInterface = errors.New("no dynamic interface available for Argo Rollouts")
+
+// rollout is an Argo Rollout. The rollout is accessed using the dynamic client, so that the Argo Rollout API
+// doesn't become a dependency. Only rollouts that declare their own pod template are supported. Rollouts using
+// a workloadRef will have an empty pod template.
+type rollout struct {
+	*unstructured.Unstructured
+	template *core.PodTemplateSpec
+}
+
+// isRolloutUnavailable returns true if the error indicates that Argo Rollouts cannot be accessed because the
+// context lacks a dynamic.Interface. A missing Argo Rollout CRD results in a NotFound error.
+func isRolloutUnavailable(err error) bool {
+	return errors.Is(err, errNoDynamicInterface)
+}
+
+func GetRollout(c context.Context, name, namespace string) (k8sapi.Workload, error) {
+	ri, err := rollouts(c, namespace)
+	if err != nil {
+		return nil, err
+	}
+	u, err := ri.Get(c, name, meta.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return Rollout(u), nil
+}
+
+// Rollouts returns all Argo Rollouts found in the given Namespace. An empty list is returned if the
+// Argo Rollout CRD isn't installed, or if the caller isn't permitted to list rollouts.
+func Rollouts(c context.Context, namespace string, labelSelector labels.Set) ([]k8sapi.Workload, error) {
+	ri, err := rollouts(c, namespace)
+	if err != nil {
+		return nil, nil
+	}
+	ls, err := ri.List(c, listOptions(labelSelector))
+	if err != nil {
+		switch {
+		case errors2.IsNotFound(err):
+			// The Argo Rollout CRD isn't installed
+			return nil, nil
+		case errors2.IsForbidden(err):
+			dlog.Debugf(c, "not permitted to list rollouts in namespace %s: %v", namespace, err)
+			return nil, nil
+		}
+		return nil, err
+	}
+	is := ls.Items
+	os := make([]k8sapi.Workload, len(is))
+	for i := range is {
+		os[i] = Rollout(&is[i])
+	}
+	return os, nil
+}
+
+func Rollout(u *unstructured.Unstructured) k8sapi.Workload {
+	return &rollout{Unstructured: u}
+}
+
+// RolloutImpl casts the given Object as an *unstructured.Unstructured representing an Argo Rollout and
+// returns it together with a status flag indicating whether the cast was possible.
+func RolloutImpl(o k8sapi.Object) (*unstructured.Unstructured, bool) {
+	if s, ok := o.(*rollout); ok {
+		return s.Unstructured, true
+	}
+	return nil, false
+}
+
+func rollouts(c context.Context, namespace string) (dynamic.ResourceInterface, error) {
+	di := GetDynamicInterface(c)
+	if di == nil {
+		return nil, errNoDynamicInterface
+	}
+	return di.Resource(RolloutResource).Namespace(namespace), nil
+}
+
+func (o *rollout) ki(c context.Context) (dynamic.ResourceInterface, error) {
+	return rollouts(c, o.GetNamespace())
+}
+
+func (o *rollout) setUnstructured(u *unstructured.Unstructured) {
+	o.Unstructured = u
+	o.template = nil
+}
+
+func (o *rollout) GetKind() string {
+	return RolloutKind
+}
+
+func (o *rollout) Delete(c context.Context) error {
+	ri, err := o.ki(c)
+	if err != nil {
+		return err
+	}
+	return ri.Delete(c, o.GetName(), meta.DeleteOptions{})
+}
+
+// GetPodTemplate returns the pod template of the rollout. Modifications to the template
+// will be reflected in the rollout when it is updated using Update.
+func (o *rollout) GetPodTemplate() *core.PodTemplateSpec {
+	if o.template == nil {
+		o.template = &core.PodTemplateSpec{}
+		if tm, ok, _ := unstructured.NestedMap(o.Object, "spec", "template"); ok {
+			_ = runtime.DefaultUnstructuredConverter.FromUnstructured(tm, o.template)
+		}
+	}
+	return o.template
+}
+
+// Patch patches the rollout. A strategic merge patch is not supported by custom resources, so it
+// is sent as a JSON merge patch, which is equivalent as long as the patch doesn't contain lists.
+func (o *rollout) Patch(c context.Context, pt types.PatchType, data []byte, subresources ...string) error {
+	ri, err := o.ki(c)
+	if err != nil {
+		return err
+	}
+	if pt == types.StrategicMergePatchType {
+		pt = types.MergePatchType
+	}
+	u, err := ri.Patch(c, o.GetName(), pt, data, meta.PatchOptions{}, subresources...)
+	if err == nil {
+		o.setUnstructured(u)
+	}
+	return err
+}
+
+func (o *rollout) Refresh(c context.Context) error {
+	ri, err := o.ki(c)
+	if err != nil {
+		return err
+	}
+	u, err := ri.Get(c, o.GetName(), meta.GetOptions{})
+	if err == nil {
+		o.setUnstructured(u)
+	}
+	return err
+}
+
+func (o *rollout) Replicas() int {
+	r, _, _ := unstructured.NestedInt64(o.Object, "status", "replicas")
+	return int(r)
+}
+
+func (o *rollout) Selector() (labels.Selector, error) {
+	sm, ok, err := unstructured.NestedMap(o.Object, "spec", "selector")
+	if err != nil || !ok {
+		return nil, fmt.Errorf("rollout %s.%s has no valid selector: %v", o.GetName(), o.GetNamespace(), err)
+	}
+	var ls meta.LabelSelector
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(sm, &ls); err != nil {
+		return nil, err
+	}
+	return meta.LabelSelectorAsSelector(&ls)
+}
+
+func (o *rollout) Update(c context.Context) error {
+	ri, err := o.ki(c)
+	if err != nil {
+		return err
+	}
+	if o.template != nil {
+		tm, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o.template)
+		if err != nil {
+			return err
+		}
+		if err = unstructured.SetNestedMap(o.Object, tm, "spec", "template"); err != nil {
+			return err
+		}
+	}
+	u, err := ri.Update(c, o.Unstructured, meta.UpdateOptions{})
+	if err == nil {
+		o.setUnstructured(u)
+	}
+	return err
+}
+
+func (o *rollout) Updated(origGeneration int64) bool {
+	// The Argo Rollout status.observedGeneration is a string.
+	var observedGeneration int64
+	if og, ok, _ := unstructured.NestedFieldNoCopy(o.Object, "status", "observedGeneration"); ok {
+		switch og := og.(type) {
+		case string:
+			observedGeneration, _ = strconv.ParseInt(og, 10, 64)
+		case int64:
+			observedGeneration = og
+		}
+	}
+	status := func(name string) int64 {
+		v, _, _ := unstructured.NestedInt64(o.Object, "status", name)
+		return v
+	}
+	replicas := status("replicas")
+	applied := o.GetGeneration() >= origGeneration &&
+		observedGeneration == o.GetGeneration() &&
+		status("updatedReplicas") == replicas &&
+		status("availableReplicas") == replicas
+	return applied
+}
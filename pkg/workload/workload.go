@@ -0,0 +1,130 @@
+// Package workload extends the set of workload kinds that are provided by the k8sapi package
+// with DaemonSets, Argo Rollouts, and bare Pods (pods that aren't controlled by another object).
+package workload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	errors2 "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+)
+
+const (
+	DeploymentKind  = "Deployment"
+	ReplicaSetKind  = "ReplicaSet"
+	StatefulSetKind = "StatefulSet"
+	DaemonSetKind   = "DaemonSet"
+	RolloutKind     = "Rollout"
+	PodKind         = "Pod"
+)
+
+// SearchOrder is the order in which workload kinds are searched when GetWorkload is called
+// with an empty workloadKind.
+var SearchOrder = []string{ //nolint:gochecknoglobals // constant
+	DeploymentKind,
+	ReplicaSetKind,
+	StatefulSetKind,
+	DaemonSetKind,
+	RolloutKind,
+	PodKind,
+}
+
+type diKey struct{}
+
+// WithDynamicInterface returns a context that carries the given dynamic.Interface. The interface
+// is needed when accessing Argo Rollouts.
+func WithDynamicInterface(ctx context.Context, di dynamic.Interface) context.Context {
+	return context.WithValue(ctx, diKey{}, di)
+}
+
+// GetDynamicInterface returns the dynamic.Interface of the given context, or nil if the
+// context doesn't have one.
+func GetDynamicInterface(ctx context.Context) dynamic.Interface {
+	di, _ := ctx.Value(diKey{}).(dynamic.Interface)
+	return di
+}
+
+// GetWorkload returns a workload for the given name, namespace, and workloadKind. The workloadKind
+// is optional. A search is performed in the order declared by SearchOrder if it is empty. Only bare
+// pods are considered when searching for pods.
+//
+// The first match is returned.
+func GetWorkload(c context.Context, name, namespace, workloadKind string) (obj k8sapi.Workload, err error) {
+	switch workloadKind {
+	case DaemonSetKind:
+		obj, err = GetDaemonSet(c, name, namespace)
+	case RolloutKind:
+		obj, err = GetRollout(c, name, namespace)
+	case PodKind:
+		obj, err = GetPod(c, name, namespace)
+	case "":
+		for _, wk := range SearchOrder {
+			if obj, err = GetWorkload(c, name, namespace, wk); err == nil {
+				return obj, nil
+			}
+			if !(errors2.IsNotFound(err) || wk == RolloutKind && isRolloutUnavailable(err) || errors.Is(err, ErrNotBarePod)) {
+				return nil, err
+			}
+		}
+		err = errors2.NewNotFound(core.Resource("workload"), name+"."+namespace)
+	default:
+		obj, err = k8sapi.GetWorkload(c, name, namespace, workloadKind)
+	}
+	return obj, err
+}
+
+// WrapWorkload wraps the given object in a Workload.
+func WrapWorkload(o runtime.Object) (k8sapi.Workload, error) {
+	switch o := o.(type) {
+	case *apps.DaemonSet:
+		return DaemonSet(o), nil
+	case *core.Pod:
+		return Pod(o), nil
+	case *unstructured.Unstructured:
+		if o.GetKind() == RolloutKind {
+			return Rollout(o), nil
+		}
+		return nil, fmt.Errorf("unsupported workload kind %s", o.GetKind())
+	default:
+		return k8sapi.WrapWorkload(o)
+	}
+}
+
+// Workloads returns all workloads of the given kind that are found in the given namespace
+// and match the given labelSelector.
+func Workloads(c context.Context, kind, namespace string, labelSelector labels.Set) ([]k8sapi.Workload, error) {
+	switch kind {
+	case DeploymentKind:
+		return k8sapi.Deployments(c, namespace, labelSelector)
+	case ReplicaSetKind:
+		return k8sapi.ReplicaSets(c, namespace, labelSelector)
+	case StatefulSetKind:
+		return k8sapi.StatefulSets(c, namespace, labelSelector)
+	case DaemonSetKind:
+		return DaemonSets(c, namespace, labelSelector)
+	case RolloutKind:
+		return Rollouts(c, namespace, labelSelector)
+	case PodKind:
+		return BarePods(c, namespace, labelSelector)
+	default:
+		return nil, k8sapi.UnsupportedWorkloadKindError(kind)
+	}
+}
+
+func listOptions(labelSelector labels.Set) meta.ListOptions {
+	var opts meta.ListOptions
+	if len(labelSelector) > 0 {
+		opts.LabelSelector = labelSelector.String()
+	}
+	return opts
+}
@@ -0,0 +1,158 @@
+package workload_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	errors2 "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+	"github.com/telepresenceio/telepresence/v2/pkg/workload"
+)
+
+const namespace = "default"
+
+func testContext(t *testing.T) context.Context {
+	ctx := dlog.NewTestContext(t, false)
+	isController := true
+	ki := fake.NewSimpleClientset(
+		&apps.DaemonSet{
+			ObjectMeta: meta.ObjectMeta{Name: "node-local", Namespace: namespace},
+		},
+		&core.Pod{
+			ObjectMeta: meta.ObjectMeta{Name: "bare", Namespace: namespace, Labels: map[string]string{"app": "bare"}},
+		},
+		&core.Pod{
+			ObjectMeta: meta.ObjectMeta{
+				Name:      "node-local-xyz12",
+				Namespace: namespace,
+				Labels:    map[string]string{"app": "bare"},
+				OwnerReferences: []meta.OwnerReference{{
+					APIVersion: "apps/v1",
+					Kind:       "DaemonSet",
+					Name:       "node-local",
+					Controller: &isController,
+				}},
+			},
+		},
+	)
+	ctx = k8sapi.WithK8sInterface(ctx, ki)
+
+	ro := &unstructured.Unstructured{}
+	ro.SetAPIVersion("argoproj.io/v1alpha1")
+	ro.SetKind("Rollout")
+	ro.SetName("rolled")
+	ro.SetNamespace(namespace)
+	require.NoError(t, unstructured.SetNestedField(ro.Object, map[string]any{
+		"metadata": map[string]any{
+			"labels": map[string]any{"app": "rolled"},
+		},
+		"spec": map[string]any{
+			"containers": []any{
+				map[string]any{
+					"name":  "echo",
+					"image": "echo:latest",
+					"ports": []any{
+						map[string]any{"name": "http", "containerPort": int64(8080)},
+					},
+				},
+			},
+		},
+	}, "spec", "template"))
+	require.NoError(t, unstructured.SetNestedField(ro.Object, map[string]any{
+		"matchLabels": map[string]any{"app": "rolled"},
+	}, "spec", "selector"))
+
+	scheme := runtime.NewScheme()
+	di := dynfake.NewSimpleDynamicClientWithCustomListKinds(scheme, map[schema.GroupVersionResource]string{
+		workload.RolloutResource: "RolloutList",
+	}, ro)
+	return workload.WithDynamicInterface(ctx, di)
+}
+
+func TestGetWorkload(t *testing.T) {
+	ctx := testContext(t)
+
+	wl, err := workload.GetWorkload(ctx, "node-local", namespace, "")
+	require.NoError(t, err)
+	assert.Equal(t, workload.DaemonSetKind, wl.GetKind())
+	_, ok := workload.DaemonSetImpl(wl)
+	assert.True(t, ok)
+
+	wl, err = workload.GetWorkload(ctx, "bare", namespace, "")
+	require.NoError(t, err)
+	assert.Equal(t, workload.PodKind, wl.GetKind())
+	assert.Equal(t, 1, wl.Replicas())
+
+	wl, err = workload.GetWorkload(ctx, "rolled", namespace, "")
+	require.NoError(t, err)
+	assert.Equal(t, workload.RolloutKind, wl.GetKind())
+	pt := wl.GetPodTemplate()
+	require.Len(t, pt.Spec.Containers, 1)
+	assert.Equal(t, int32(8080), pt.Spec.Containers[0].Ports[0].ContainerPort)
+	assert.Equal(t, "rolled", pt.Labels["app"])
+	sel, err := wl.Selector()
+	require.NoError(t, err)
+	assert.Equal(t, "app=rolled", sel.String())
+
+	// A pod that is controlled by a DaemonSet is not a workload
+	_, err = workload.GetWorkload(ctx, "node-local-xyz12", namespace, "")
+	assert.True(t, errors2.IsNotFound(err))
+	_, err = workload.GetWorkload(ctx, "node-local-xyz12", namespace, workload.PodKind)
+	assert.ErrorIs(t, err, workload.ErrNotBarePod)
+}
+
+func TestGetWorkload_noRollouts(t *testing.T) {
+	ctx := testContext(t)
+	ctx = workload.WithDynamicInterface(ctx, nil)
+
+	// Lack of Argo Rollout support doesn't prevent the search from reaching bare pods.
+	wl, err := workload.GetWorkload(ctx, "bare", namespace, "")
+	require.NoError(t, err)
+	assert.Equal(t, workload.PodKind, wl.GetKind())
+
+	rls, err := workload.Rollouts(ctx, namespace, nil)
+	require.NoError(t, err)
+	assert.Empty(t, rls)
+}
+
+func TestBarePods(t *testing.T) {
+	ctx := testContext(t)
+	wls, err := workload.BarePods(ctx, namespace, map[string]string{"app": "bare"})
+	require.NoError(t, err)
+	require.Len(t, wls, 1)
+	assert.Equal(t, "bare", wls[0].GetName())
+}
+
+func TestListForbidden(t *testing.T) {
+	ctx := testContext(t)
+	forbidden := func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors2.NewForbidden(action.GetResource().GroupResource(), "", nil)
+	}
+	k8sapi.GetK8sInterface(ctx).(*fake.Clientset).PrependReactor("list", "pods", forbidden)
+	di := dynfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		workload.RolloutResource: "RolloutList",
+	})
+	di.PrependReactor("list", "rollouts", forbidden)
+	ctx = workload.WithDynamicInterface(ctx, di)
+
+	wls, err := workload.BarePods(ctx, namespace, nil)
+	require.NoError(t, err)
+	assert.Empty(t, wls)
+
+	rls, err := workload.Rollouts(ctx, namespace, nil)
+	require.NoError(t, err)
+	assert.Empty(t, rls)
+}
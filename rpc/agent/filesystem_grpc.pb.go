@@ -0,0 +1,593 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.9
+// source: agent/filesystem.proto
+
+package agent
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// FileSystemClient is the client API for FileSystem service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FileSystemClient interface {
+	// Stat returns information about the file with the given path.
+	Stat(ctx context.Context, in *PathRequest, opts ...grpc.CallOption) (*FileInfo, error)
+	// ReadDir returns the entries of the directory with the given path.
+	ReadDir(ctx context.Context, in *PathRequest, opts ...grpc.CallOption) (*DirEntries, error)
+	// Read streams the contents of a file, starting at the requested offset,
+	// in chunks of at most chunk_size bytes. The stream ends when length bytes
+	// have been sent or when the end of the file is reached.
+	Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (FileSystem_ReadClient, error)
+	// Write writes the given data at the given offset of an existing file.
+	Write(ctx context.Context, in *WriteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Create creates or truncates the file with the given path.
+	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*FileInfo, error)
+	// Truncate changes the size of the file with the given path.
+	Truncate(ctx context.Context, in *TruncateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Mkdir creates a directory.
+	Mkdir(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Remove removes a file or an empty directory, or, when recursive is set,
+	// a directory and everything that it contains.
+	Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Rename renames (moves) a file or directory.
+	Rename(ctx context.Context, in *RenameRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Chmod changes the mode of a file or directory.
+	Chmod(ctx context.Context, in *ChmodRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Chtimes changes the access and modification times of a file or directory.
+	Chtimes(ctx context.Context, in *ChtimesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// WatchChanges streams the paths of files and directories that have changed
+	// in the exported directory, so that the client can invalidate what it has
+	// cached. Only directories that have been read using ReadDir, and the files
+	// that they contain, are reported.
+	WatchChanges(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (FileSystem_WatchChangesClient, error)
+}
+
+type fileSystemClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFileSystemClient(cc grpc.ClientConnInterface) FileSystemClient {
+	return &fileSystemClient{cc}
+}
+
+func (c *fileSystemClient) Stat(ctx context.Context, in *PathRequest, opts ...grpc.CallOption) (*FileInfo, error) {
+	out := new(FileInfo)
+	err := c.cc.Invoke(ctx, "/telepresence.agent.FileSystem/Stat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileSystemClient) ReadDir(ctx context.Context, in *PathRequest, opts ...grpc.CallOption) (*DirEntries, error) {
+	out := new(DirEntries)
+	err := c.cc.Invoke(ctx, "/telepresence.agent.FileSystem/ReadDir", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileSystemClient) Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (FileSystem_ReadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FileSystem_ServiceDesc.Streams[0], "/telepresence.agent.FileSystem/Read", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &fileSystemReadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FileSystem_ReadClient interface {
+	Recv() (*Chunk, error)
+	grpc.ClientStream
+}
+
+type fileSystemReadClient struct {
+	grpc.ClientStream
+}
+
+func (x *fileSystemReadClient) Recv() (*Chunk, error) {
+	m := new(Chunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *fileSystemClient) Write(ctx context.Context, in *WriteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/telepresence.agent.FileSystem/Write", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileSystemClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*FileInfo, error) {
+	out := new(FileInfo)
+	err := c.cc.Invoke(ctx, "/telepresence.agent.FileSystem/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileSystemClient) Truncate(ctx context.Context, in *TruncateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/telepresence.agent.FileSystem/Truncate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileSystemClient) Mkdir(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/telepresence.agent.FileSystem/Mkdir", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileSystemClient) Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/telepresence.agent.FileSystem/Remove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileSystemClient) Rename(ctx context.Context, in *RenameRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/telepresence.agent.FileSystem/Rename", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileSystemClient) Chmod(ctx context.Context, in *ChmodRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/telepresence.agent.FileSystem/Chmod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileSystemClient) Chtimes(ctx context.Context, in *ChtimesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/telepresence.agent.FileSystem/Chtimes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileSystemClient) WatchChanges(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (FileSystem_WatchChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FileSystem_ServiceDesc.Streams[1], "/telepresence.agent.FileSystem/WatchChanges", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &fileSystemWatchChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FileSystem_WatchChangesClient interface {
+	Recv() (*Changes, error)
+	grpc.ClientStream
+}
+
+type fileSystemWatchChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *fileSystemWatchChangesClient) Recv() (*Changes, error) {
+	m := new(Changes)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// FileSystemServer is the server API for FileSystem service.
+// All implementations must embed UnimplementedFileSystemServer
+// for forward compatibility
+type FileSystemServer interface {
+	// Stat returns information about the file with the given path.
+	Stat(context.Context, *PathRequest) (*FileInfo, error)
+	// ReadDir returns the entries of the directory with the given path.
+	ReadDir(context.Context, *PathRequest) (*DirEntries, error)
+	// Read streams the contents of a file, starting at the requested offset,
+	// in chunks of at most chunk_size bytes. The stream ends when length bytes
+	// have been sent or when the end of the file is reached.
+	Read(*ReadRequest, FileSystem_ReadServer) error
+	// Write writes the given data at the given offset of an existing file.
+	Write(context.Context, *WriteRequest) (*emptypb.Empty, error)
+	// Create creates or truncates the file with the given path.
+	Create(context.Context, *CreateRequest) (*FileInfo, error)
+	// Truncate changes the size of the file with the given path.
+	Truncate(context.Context, *TruncateRequest) (*emptypb.Empty, error)
+	// Mkdir creates a directory.
+	Mkdir(context.Context, *CreateRequest) (*emptypb.Empty, error)
+	// Remove removes a file or an empty directory, or, when recursive is set,
+	// a directory and everything that it contains.
+	Remove(context.Context, *RemoveRequest) (*emptypb.Empty, error)
+	// Rename renames (moves) a file or directory.
+	Rename(context.Context, *RenameRequest) (*emptypb.Empty, error)
+	// Chmod changes the mode of a file or directory.
+	Chmod(context.Context, *ChmodRequest) (*emptypb.Empty, error)
+	// Chtimes changes the access and modification times of a file or directory.
+	Chtimes(context.Context, *ChtimesRequest) (*emptypb.Empty, error)
+	// WatchChanges streams the paths of files and directories that have changed
+	// in the exported directory, so that the client can invalidate what it has
+	// cached. Only directories that have been read using ReadDir, and the files
+	// that they contain, are reported.
+	WatchChanges(*emptypb.Empty, FileSystem_WatchChangesServer) error
+	mustEmbedUnimplementedFileSystemServer()
+}
+
+// UnimplementedFileSystemServer must be embedded to have forward compatible implementations.
+type UnimplementedFileSystemServer struct {
+}
+
+func (UnimplementedFileSystemServer) Stat(context.Context, *PathRequest) (*FileInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stat not implemented")
+}
+func (UnimplementedFileSystemServer) ReadDir(context.Context, *PathRequest) (*DirEntries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadDir not implemented")
+}
+func (UnimplementedFileSystemServer) Read(*ReadRequest, FileSystem_ReadServer) error {
+	return status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (UnimplementedFileSystemServer) Write(context.Context, *WriteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Write not implemented")
+}
+func (UnimplementedFileSystemServer) Create(context.Context, *CreateRequest) (*FileInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedFileSystemServer) Truncate(context.Context, *TruncateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Truncate not implemented")
+}
+func (UnimplementedFileSystemServer) Mkdir(context.Context, *CreateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mkdir not implemented")
+}
+func (UnimplementedFileSystemServer) Remove(context.Context, *RemoveRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Remove not implemented")
+}
+func (UnimplementedFileSystemServer) Rename(context.Context, *RenameRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rename not implemented")
+}
+func (UnimplementedFileSystemServer) Chmod(context.Context, *ChmodRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Chmod not implemented")
+}
+func (UnimplementedFileSystemServer) Chtimes(context.Context, *ChtimesRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Chtimes not implemented")
+}
+func (UnimplementedFileSystemServer) WatchChanges(*emptypb.Empty, FileSystem_WatchChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchChanges not implemented")
+}
+func (UnimplementedFileSystemServer) mustEmbedUnimplementedFileSystemServer() {}
+
+// UnsafeFileSystemServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FileSystemServer will
+// result in compilation errors.
+type UnsafeFileSystemServer interface {
+	mustEmbedUnimplementedFileSystemServer()
+}
+
+func RegisterFileSystemServer(s grpc.ServiceRegistrar, srv FileSystemServer) {
+	s.RegisterService(&FileSystem_ServiceDesc, srv)
+}
+
+func _FileSystem_Stat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileSystemServer).Stat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telepresence.agent.FileSystem/Stat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileSystemServer).Stat(ctx, req.(*PathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileSystem_ReadDir_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileSystemServer).ReadDir(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telepresence.agent.FileSystem/ReadDir",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileSystemServer).ReadDir(ctx, req.(*PathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileSystem_Read_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FileSystemServer).Read(m, &fileSystemReadServer{stream})
+}
+
+type FileSystem_ReadServer interface {
+	Send(*Chunk) error
+	grpc.ServerStream
+}
+
+type fileSystemReadServer struct {
+	grpc.ServerStream
+}
+
+func (x *fileSystemReadServer) Send(m *Chunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _FileSystem_Write_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileSystemServer).Write(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telepresence.agent.FileSystem/Write",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileSystemServer).Write(ctx, req.(*WriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileSystem_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileSystemServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telepresence.agent.FileSystem/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileSystemServer).Create(ctx, req.(*CreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileSystem_Truncate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TruncateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileSystemServer).Truncate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telepresence.agent.FileSystem/Truncate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileSystemServer).Truncate(ctx, req.(*TruncateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileSystem_Mkdir_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileSystemServer).Mkdir(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telepresence.agent.FileSystem/Mkdir",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileSystemServer).Mkdir(ctx, req.(*CreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileSystem_Remove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileSystemServer).Remove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telepresence.agent.FileSystem/Remove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileSystemServer).Remove(ctx, req.(*RemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileSystem_Rename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileSystemServer).Rename(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telepresence.agent.FileSystem/Rename",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileSystemServer).Rename(ctx, req.(*RenameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileSystem_Chmod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChmodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileSystemServer).Chmod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telepresence.agent.FileSystem/Chmod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileSystemServer).Chmod(ctx, req.(*ChmodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileSystem_Chtimes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChtimesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileSystemServer).Chtimes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telepresence.agent.FileSystem/Chtimes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileSystemServer).Chtimes(ctx, req.(*ChtimesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileSystem_WatchChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FileSystemServer).WatchChanges(m, &fileSystemWatchChangesServer{stream})
+}
+
+type FileSystem_WatchChangesServer interface {
+	Send(*Changes) error
+	grpc.ServerStream
+}
+
+type fileSystemWatchChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *fileSystemWatchChangesServer) Send(m *Changes) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// FileSystem_ServiceDesc is the grpc.ServiceDesc for FileSystem service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FileSystem_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "telepresence.agent.FileSystem",
+	HandlerType: (*FileSystemServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Stat",
+			Handler:    _FileSystem_Stat_Handler,
+		},
+		{
+			MethodName: "ReadDir",
+			Handler:    _FileSystem_ReadDir_Handler,
+		},
+		{
+			MethodName: "Write",
+			Handler:    _FileSystem_Write_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _FileSystem_Create_Handler,
+		},
+		{
+			MethodName: "Truncate",
+			Handler:    _FileSystem_Truncate_Handler,
+		},
+		{
+			MethodName: "Mkdir",
+			Handler:    _FileSystem_Mkdir_Handler,
+		},
+		{
+			MethodName: "Remove",
+			Handler:    _FileSystem_Remove_Handler,
+		},
+		{
+			MethodName: "Rename",
+			Handler:    _FileSystem_Rename_Handler,
+		},
+		{
+			MethodName: "Chmod",
+			Handler:    _FileSystem_Chmod_Handler,
+		},
+		{
+			MethodName: "Chtimes",
+			Handler:    _FileSystem_Chtimes_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Read",
+			Handler:       _FileSystem_Read_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchChanges",
+			Handler:       _FileSystem_WatchChanges_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "agent/filesystem.proto",
+}
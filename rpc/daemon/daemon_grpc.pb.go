@@ -43,6 +43,12 @@ type DaemonClient interface {
 	SetLogLevel(ctx context.Context, in *manager.LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// WaitForNetwork waits for the network of the currently connected session to become ready.
 	WaitForNetwork(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// WatchConnections sends a snapshot of the live connections that are routed to the cluster
+	// through the TUN device, and then a new snapshot at the requested interval.
+	WatchConnections(ctx context.Context, in *WatchConnectionsRequest, opts ...grpc.CallOption) (Daemon_WatchConnectionsClient, error)
+	// CheckDNS resolves a service name using the DNS server of the currently connected session, and
+	// reports the outcome of the server's check for queries that recurse back to it from the cluster.
+	CheckDNS(ctx context.Context, in *CheckDNSRequest, opts ...grpc.CallOption) (*CheckDNSResponse, error)
 }
 
 type daemonClient struct {
@@ -134,6 +140,47 @@ func (c *daemonClient) WaitForNetwork(ctx context.Context, in *emptypb.Empty, op
 	return out, nil
 }
 
+func (c *daemonClient) WatchConnections(ctx context.Context, in *WatchConnectionsRequest, opts ...grpc.CallOption) (Daemon_WatchConnectionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[0], "/telepresence.daemon.Daemon/WatchConnections", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonWatchConnectionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Daemon_WatchConnectionsClient interface {
+	Recv() (*Connections, error)
+	grpc.ClientStream
+}
+
+type daemonWatchConnectionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonWatchConnectionsClient) Recv() (*Connections, error) {
+	m := new(Connections)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daemonClient) CheckDNS(ctx context.Context, in *CheckDNSRequest, opts ...grpc.CallOption) (*CheckDNSResponse, error) {
+	out := new(CheckDNSResponse)
+	err := c.cc.Invoke(ctx, "/telepresence.daemon.Daemon/CheckDNS", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DaemonServer is the server API for Daemon service.
 // All implementations must embed UnimplementedDaemonServer
 // for forward compatibility
@@ -156,6 +203,12 @@ type DaemonServer interface {
 	SetLogLevel(context.Context, *manager.LogLevelRequest) (*emptypb.Empty, error)
 	// WaitForNetwork waits for the network of the currently connected session to become ready.
 	WaitForNetwork(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// WatchConnections sends a snapshot of the live connections that are routed to the cluster
+	// through the TUN device, and then a new snapshot at the requested interval.
+	WatchConnections(*WatchConnectionsRequest, Daemon_WatchConnectionsServer) error
+	// CheckDNS resolves a service name using the DNS server of the currently connected session, and
+	// reports the outcome of the server's check for queries that recurse back to it from the cluster.
+	CheckDNS(context.Context, *CheckDNSRequest) (*CheckDNSResponse, error)
 	mustEmbedUnimplementedDaemonServer()
 }
 
@@ -190,6 +243,12 @@ func (UnimplementedDaemonServer) SetLogLevel(context.Context, *manager.LogLevelR
 func (UnimplementedDaemonServer) WaitForNetwork(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WaitForNetwork not implemented")
 }
+func (UnimplementedDaemonServer) WatchConnections(*WatchConnectionsRequest, Daemon_WatchConnectionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchConnections not implemented")
+}
+func (UnimplementedDaemonServer) CheckDNS(context.Context, *CheckDNSRequest) (*CheckDNSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckDNS not implemented")
+}
 func (UnimplementedDaemonServer) mustEmbedUnimplementedDaemonServer() {}
 
 // UnsafeDaemonServer may be embedded to opt out of forward compatibility for this service.
@@ -365,6 +424,45 @@ func _Daemon_WaitForNetwork_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Daemon_WatchConnections_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchConnectionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServer).WatchConnections(m, &daemonWatchConnectionsServer{stream})
+}
+
+type Daemon_WatchConnectionsServer interface {
+	Send(*Connections) error
+	grpc.ServerStream
+}
+
+type daemonWatchConnectionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *daemonWatchConnectionsServer) Send(m *Connections) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Daemon_CheckDNS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckDNSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).CheckDNS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telepresence.daemon.Daemon/CheckDNS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).CheckDNS(ctx, req.(*CheckDNSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Daemon_ServiceDesc is the grpc.ServiceDesc for Daemon service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -408,7 +506,17 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WaitForNetwork",
 			Handler:    _Daemon_WaitForNetwork_Handler,
 		},
+		{
+			MethodName: "CheckDNS",
+			Handler:    _Daemon_CheckDNS_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchConnections",
+			Handler:       _Daemon_WatchConnections_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "daemon/daemon.proto",
 }